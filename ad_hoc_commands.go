@@ -0,0 +1,235 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AdHocCommandList represents the output of the ListAdHocCommands method.
+type AdHocCommandList struct {
+	ListGetResponse
+	Results []*AdHocCommand `json:"results,omitempty"`
+}
+
+// AdHocCommand represents an ad hoc command, a single module run against the
+// hosts of an inventory.
+type AdHocCommand struct {
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	URL            string    `json:"url"`
+	Type           string    `json:"type"`
+	Modified       string    `json:"modified"`
+	Created        string    `json:"created"`
+	LaunchType     string    `json:"launch_type"`
+	Status         string    `json:"status"`
+	Failed         bool      `json:"failed"`
+	Started        time.Time `json:"started"`
+	Finished       time.Time `json:"finished"`
+	Elapsed        float64   `json:"elapsed"`
+	JobExplanation string    `json:"job_explanation"`
+	JobType        string    `json:"job_type"`
+	Inventory      int       `json:"inventory"`
+	Limit          string    `json:"limit"`
+	Credential     int       `json:"credential"`
+	ModuleName     string    `json:"module_name"`
+	ModuleArgs     string    `json:"module_args"`
+	Forks          int       `json:"forks"`
+	Verbosity      int       `json:"verbosity"`
+	ExtraVars      string    `json:"extra_vars"`
+	BecomeEnabled  bool      `json:"become_enabled"`
+	DiffMode       bool      `json:"diff_mode"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// AdHocCommandInput represents the input of the LaunchAdHocCommand and
+// LaunchInventoryAdHocCommand methods.
+type AdHocCommandInput struct {
+	// Inventory is only needed when launching through LaunchAdHocCommand.
+	Inventory     int       `json:"inventory,omitempty"`
+	JobType       string    `json:"job_type,omitempty"`
+	ModuleName    string    `json:"module_name"`
+	ModuleArgs    string    `json:"module_args,omitempty"`
+	Limit         string    `json:"limit,omitempty"`
+	Credential    int       `json:"credential"`
+	BecomeEnabled bool      `json:"become_enabled,omitempty"`
+	Forks         int       `json:"forks,omitempty"`
+	Verbosity     int       `json:"verbosity,omitempty"`
+	DiffMode      bool      `json:"diff_mode,omitempty"`
+	ExtraVars     Variables `json:"extra_vars,omitempty"`
+}
+
+// Validate checks that the mandatory fields of the input are set.
+func (in *AdHocCommandInput) Validate() error {
+	var errs []error
+	if in.ModuleName == "" {
+		errs = append(errs, errors.New("ad hoc command: module_name is mandatory"))
+	}
+	if in.Credential == 0 {
+		errs = append(errs, errors.New("ad hoc command: credential is mandatory"))
+	}
+	if in.Verbosity < 0 || in.Verbosity > 5 {
+		errs = append(errs, errors.New("ad hoc command: verbosity must be between 0 and 5"))
+	}
+	return errors.Join(errs...)
+}
+
+// AdHocCommandEventList represents the output of the ListAdHocCommandEvents method.
+type AdHocCommandEventList struct {
+	ListGetResponse
+	Results []*AdHocCommandEvent `json:"results,omitempty"`
+}
+
+// AdHocCommandEvent represents an event emitted while running an ad hoc command.
+type AdHocCommandEvent struct {
+	ID        int            `json:"id"`
+	Type      string         `json:"type"`
+	Created   string         `json:"created"`
+	Event     string         `json:"event"`
+	Counter   int            `json:"counter"`
+	Host      *int           `json:"host"`
+	HostName  string         `json:"host_name"`
+	Failed    bool           `json:"failed"`
+	Changed   bool           `json:"changed"`
+	Stdout    string         `json:"stdout"`
+	EventData map[string]any `json:"event_data"`
+}
+
+// ListAdHocCommandEventsInput represents the input of the ListAdHocCommandEvents method.
+type ListAdHocCommandEventsInput struct {
+	Event    string `schema:"event,omitempty"`
+	HostName string `schema:"host_name,omitempty"`
+	Failed   *bool  `schema:"failed,omitempty"`
+	OrderBy  string `schema:"order_by,omitempty"`
+}
+
+// Host results of an ad hoc command, derived from the runner events.
+const (
+	AdHocHostOK          = "ok"
+	AdHocHostFailed      = "failed"
+	AdHocHostUnreachable = "unreachable"
+	AdHocHostSkipped     = "skipped"
+)
+
+// AdHocHostResult represents the outcome of an ad hoc command on one host.
+type AdHocHostResult struct {
+	HostName string
+	Status   string
+	Changed  bool
+	Stdout   string
+	// Result is the result returned by the module, if reported.
+	Result map[string]any
+}
+
+const adHocCommandsResource = "ad_hoc_commands"
+
+// GetAdHocCommand retrieves the ad hoc command with the given id.
+func GetAdHocCommand(ctx context.Context, c Client, id int) (*AdHocCommand, error) {
+	return getByID[AdHocCommand](ctx, c, adHocCommandsResource, id)
+}
+
+// ListAdHocModules retrieves the modules AWX allows to run as ad hoc command,
+// as listed in the OPTIONS response of ad_hoc_commands/. c must implement
+// OptionsReader.
+func ListAdHocModules(ctx context.Context, c Client) ([]string, error) {
+	return getFieldChoices(ctx, c, ObjectKey{Resource: adHocCommandsResource}, http.MethodPost, "module_name")
+}
+
+// LaunchAdHocCommand launches an ad hoc command against input.Inventory.
+func LaunchAdHocCommand(ctx context.Context, c Client, input *AdHocCommandInput) (*AdHocCommand, error) {
+	if input.Inventory == 0 {
+		return nil, errors.New("ad hoc command: inventory is mandatory")
+	}
+	return launchAdHocCommand(ctx, c, ObjectKey{Resource: adHocCommandsResource}, input)
+}
+
+// LaunchInventoryAdHocCommand launches an ad hoc command against the
+// inventory with the given id.
+func LaunchInventoryAdHocCommand(ctx context.Context, c Client, inventoryID int, input *AdHocCommandInput) (*AdHocCommand, error) {
+	key := ObjectKey{Resource: inventoriesResource, ResourceID: strconv.Itoa(inventoryID), Action: adHocCommandsResource}
+	return launchAdHocCommand(ctx, c, key, input)
+}
+
+func launchAdHocCommand(ctx context.Context, c Client, key ObjectKey, input *AdHocCommandInput) (*AdHocCommand, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	cmd := &AdHocCommand{}
+	if err := post(ctx, c, key, input, cmd, []int{http.StatusCreated}); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// WaitAdHocCommand polls the ad hoc command with the given id every interval
+// until it finished or ctx is done.
+func WaitAdHocCommand(ctx context.Context, c Client, id int, interval time.Duration) (*AdHocCommand, error) {
+	return waitFinished(ctx, interval, func() (*AdHocCommand, string, error) {
+		cmd, err := GetAdHocCommand(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return cmd, cmd.Status, nil
+	})
+}
+
+// GetAdHocCommandStdout retrieves the stdout of the ad hoc command with the given id.
+func GetAdHocCommandStdout(ctx context.Context, c Client, id int) (string, error) {
+	return getStdout(ctx, c, adHocCommandsResource, id)
+}
+
+// ListAdHocCommandEvents retrieves the events of the ad hoc command with the
+// given id matching input.
+func ListAdHocCommandEvents(ctx context.Context, c Client, id int, input *ListAdHocCommandEventsInput) ([]*AdHocCommandEvent, error) {
+	key := ObjectKey{Resource: adHocCommandsResource, ResourceID: strconv.Itoa(id), Action: "events"}
+	return listAll[AdHocCommandEvent](ctx, c, key, input)
+}
+
+// GetAdHocCommandHostResults retrieves the events of the ad hoc command with
+// the given id and returns the outcome per host name.
+func GetAdHocCommandHostResults(ctx context.Context, c Client, id int) (map[string]*AdHocHostResult, error) {
+	events, err := ListAdHocCommandEvents(ctx, c, id, &ListAdHocCommandEventsInput{OrderBy: "counter"})
+	if err != nil {
+		return nil, err
+	}
+	results := map[string]*AdHocHostResult{}
+	for _, e := range events {
+		status, ok := strings.CutPrefix(e.Event, "runner_on_")
+		if !ok || e.HostName == "" {
+			continue
+		}
+		switch status {
+		case AdHocHostOK, AdHocHostFailed, AdHocHostUnreachable, AdHocHostSkipped:
+		default:
+			continue
+		}
+		r := &AdHocHostResult{HostName: e.HostName, Status: status, Changed: e.Changed, Stdout: e.Stdout}
+		if res, ok := e.EventData["res"].(map[string]any); ok {
+			r.Result = res
+		}
+		results[e.HostName] = r
+	}
+	return results, nil
+}
@@ -0,0 +1,134 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaunchInventoryAdHocCommand(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("OPTIONS /ad_hoc_commands/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"actions": {"POST": {"module_name": {
+			"type": "choice",
+			"required": true,
+			"choices": [["command", "command"], ["ping", "ping"], ["shell", "shell"]]
+		}}}}`)
+	})
+	mux.HandleFunc("POST /inventories/3/ad_hoc_commands/", func(w http.ResponseWriter, r *http.Request) {
+		received := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"module_name":    "shell",
+			"module_args":    "uptime",
+			"limit":          "web*",
+			"credential":     4.0,
+			"become_enabled": true,
+			"forks":          10.0,
+		}, received)
+		writeJSON(w, http.StatusCreated, `{"id": 7, "status": "pending", "module_name": "shell", "inventory": 3}`)
+	})
+	mux.HandleFunc("GET /ad_hoc_commands/7/", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "running"
+		if polls > 1 {
+			status = "failed"
+		}
+		writeJSON(w, http.StatusOK, `{"id": 7, "status": "`+status+`"}`)
+	})
+	mux.HandleFunc("GET /ad_hoc_commands/7/events/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "counter", r.URL.Query().Get("order_by"))
+		writeJSON(w, http.StatusOK, `{"results": [
+			{"id": 1, "event": "verbose", "counter": 1, "stdout": "start"},
+			{"id": 2, "event": "runner_on_ok", "counter": 2, "host_name": "web01", "changed": true,
+			 "stdout": "web01 | CHANGED", "event_data": {"res": {"stdout": "up 3 days"}}},
+			{"id": 3, "event": "runner_on_unreachable", "counter": 3, "host_name": "web02", "failed": true,
+			 "stdout": "web02 | UNREACHABLE!"}
+		]}`)
+	})
+	mux.HandleFunc("GET /ad_hoc_commands/7/stdout/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		writeJSON(w, http.StatusOK, `{"content": "web01 | CHANGED\nweb02 | UNREACHABLE!"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	modules, err := ListAdHocModules(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"command", "ping", "shell"}, modules)
+	_, err = ListAdHocModules(ctx, struct{ Client }{client})
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+
+	_, err = LaunchInventoryAdHocCommand(ctx, client, 3, &AdHocCommandInput{ModuleName: "shell"})
+	assert.ErrorContains(t, err, "credential is mandatory")
+
+	cmd, err := LaunchInventoryAdHocCommand(ctx, client, 3, &AdHocCommandInput{
+		ModuleName:    "shell",
+		ModuleArgs:    "uptime",
+		Limit:         "web*",
+		Credential:    4,
+		BecomeEnabled: true,
+		Forks:         10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, cmd.ID)
+
+	cmd, err = WaitAdHocCommand(ctx, client, cmd.ID, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, JobStatusFailed, cmd.Status)
+
+	results, err := GetAdHocCommandHostResults(ctx, client, cmd.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*AdHocHostResult{
+		"web01": {
+			HostName: "web01",
+			Status:   AdHocHostOK,
+			Changed:  true,
+			Stdout:   "web01 | CHANGED",
+			Result:   map[string]any{"stdout": "up 3 days"},
+		},
+		"web02": {HostName: "web02", Status: AdHocHostUnreachable, Stdout: "web02 | UNREACHABLE!"},
+	}, results)
+
+	stdout, err := GetAdHocCommandStdout(ctx, client, cmd.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "web01 | CHANGED\nweb02 | UNREACHABLE!", stdout)
+}
@@ -194,11 +194,20 @@ func (c *client) Ping(ctx context.Context) (output GetPingOutput, err error) {
 }
 
 func (c *client) Get(ctx context.Context, key ObjectKey, output Object, httpStatus []int) error {
+	return c.read(http.MethodGet, key, output, httpStatus)
+}
+
+func (c *client) Options(ctx context.Context, key ObjectKey, output Object, httpStatus []int) error {
+	return c.read(http.MethodOptions, key, output, httpStatus)
+}
+
+// read sends a request without body with the given method and decodes the
+// response into output.
+func (c *client) read(method string, key ObjectKey, output Object, httpStatus []int) error {
 	req := http.Request{
-		Method: http.MethodGet,
+		Method: method,
 		URL:    c.parsedURL.JoinPath(key.String()),
 	}
-	fmt.Println(req.URL)
 	if httpStatus == nil {
 		httpStatus = []int{http.StatusOK}
 	}
@@ -220,7 +229,6 @@ func (c *client) Create(ctx context.Context, key ObjectKey, obj Object, status [
 		URL:    c.parsedURL.JoinPath(key.String()),
 		Body:   io.NopCloser(&buf),
 	}
-	fmt.Println(req.URL)
 	if len(status) == 0 {
 		status = []int{http.StatusCreated}
 	}
@@ -228,13 +236,17 @@ func (c *client) Create(ctx context.Context, key ObjectKey, obj Object, status [
 	if err != nil {
 		return err
 	}
+	// Some actions (e.g. survey_spec, associate) answer with an empty body.
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
 	err = json.Unmarshal(body, &obj)
 	return err
 }
 
 func (c *client) List(ctx context.Context, key ObjectKey, obj ObjectList, options ListOption, httpStatus []int) error {
 	values := url.Values{}
-	err := encode.Encode(options, values)
+	err := encodeQuery(options, values)
 	if err != nil {
 		return err
 	}
@@ -256,7 +268,35 @@ func (c *client) List(ctx context.Context, key ObjectKey, obj ObjectList, option
 }
 
 func (c *client) Update(ctx context.Context, key ObjectKey, obj Object, httpStatus []int) error {
-	return nil
+	return c.write(http.MethodPut, key, obj, httpStatus)
+}
+
+func (c *client) Patch(ctx context.Context, key ObjectKey, obj Object, httpStatus []int) error {
+	return c.write(http.MethodPatch, key, obj, httpStatus)
+}
+
+// write sends obj with the given method and updates obj with the response.
+func (c *client) write(method string, key ObjectKey, obj Object, httpStatus []int) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(obj); err != nil {
+		return err
+	}
+	req := http.Request{
+		Method: method,
+		URL:    c.parsedURL.JoinPath(key.String()),
+		Body:   io.NopCloser(&buf),
+	}
+	if len(httpStatus) == 0 {
+		httpStatus = []int{http.StatusOK}
+	}
+	body, err := c.DoRequest(&req, httpStatus)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, obj)
 }
 
 func (c *client) Delete(ctx context.Context, key ObjectKey, httpStatus []int) error {
@@ -271,10 +311,6 @@ func (c *client) Delete(ctx context.Context, key ObjectKey, httpStatus []int) er
 	return err
 }
 
-func (c *client) Patch(ctx context.Context, path string, obj Object) error {
-	return nil
-}
-
 func (c *client) getAuthToken() error {
 	var input GetAuthTokenInput
 	var output GetAuthTokenOutput
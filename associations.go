@@ -0,0 +1,41 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+)
+
+// AssociateInput represents the input of an associate or disassociate request
+// on a sub list such as job_templates/{id}/credentials/.
+type AssociateInput struct {
+	ID           int  `json:"id"`
+	Disassociate bool `json:"disassociate,omitempty"`
+}
+
+// Associate adds the object with the given id to the sub list at key.
+func Associate(ctx context.Context, c Client, key ObjectKey, id int) error {
+	return c.Create(ctx, key, &AssociateInput{ID: id}, []int{http.StatusNoContent, http.StatusOK, http.StatusCreated})
+}
+
+// Disassociate removes the object with the given id from the sub list at key.
+func Disassociate(ctx context.Context, c Client, key ObjectKey, id int) error {
+	return c.Create(ctx, key, &AssociateInput{ID: id, Disassociate: true}, []int{http.StatusNoContent, http.StatusOK})
+}
@@ -0,0 +1,230 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Default limits of the bulk host endpoints, see BULK_HOST_MAX_CREATE and
+// BULK_HOST_MAX_DELETE in the AWX settings.
+const (
+	DefaultBulkHostCreateChunkSize = 100
+	DefaultBulkHostDeleteChunkSize = 250
+)
+
+// BulkOptions represents the options of the bulk operations.
+type BulkOptions struct {
+	// ChunkSize is the number of items sent per request, it must not exceed
+	// the limit configured on the server.
+	ChunkSize int
+	// Concurrency is the number of requests running at the same time,
+	// defaults to 1.
+	Concurrency int
+}
+
+// BulkHostResult represents the outcome of a bulk operation for a single host.
+type BulkHostResult struct {
+	Name string
+	ID   int
+	Err  error
+}
+
+// bulkHost represents a host in the input of the bulk/host_create/ endpoint.
+type bulkHost struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Enabled     bool      `json:"enabled"`
+	InstanceID  string    `json:"instance_id,omitempty"`
+	Variables   Variables `json:"variables,omitempty"`
+}
+
+// bulkHostCreateInput represents the input of the bulk/host_create/ endpoint.
+type bulkHostCreateInput struct {
+	Inventory int         `json:"inventory"`
+	Hosts     []*bulkHost `json:"hosts"`
+}
+
+// bulkHostCreateOutput represents the output of the bulk/host_create/ endpoint.
+type bulkHostCreateOutput struct {
+	Hosts []*Host `json:"hosts"`
+}
+
+// bulkHostDeleteInput represents the input of the bulk/host_delete/ endpoint.
+type bulkHostDeleteInput struct {
+	Hosts []int `json:"hosts"`
+}
+
+// runChunks calls fn for every chunk of n items, running at most
+// opts.Concurrency calls at the same time.
+func runChunks(ctx context.Context, n int, opts BulkOptions, fn func(start, end int)) {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	sem := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += opts.ChunkSize {
+		end := min(start+opts.ChunkSize, n)
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(start, end)
+		}()
+	}
+	wg.Wait()
+}
+
+// isNotFound reports whether err is an AWX error with status 404, as
+// returned by AWX versions without a given endpoint.
+func isNotFound(err error) bool {
+	var awxErr *Error
+	return errors.As(err, &awxErr) && awxErr.StatusCode == http.StatusNotFound
+}
+
+// bulkResultsErr joins the errors of all failed results.
+func bulkResultsErr(results []*BulkHostResult) error {
+	failed := 0
+	var first error
+	for _, r := range results {
+		if r.Err != nil {
+			if first == nil {
+				first = r.Err
+			}
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d hosts failed, first error: %w", failed, len(results), first)
+}
+
+// BulkCreateHosts creates hosts in the given inventory through the
+// bulk/host_create/ endpoint. The hosts are sent in chunks, a chunk either
+// succeeds or fails as a whole. On AWX versions without the bulk endpoint the
+// hosts are created one by one. The results are in the order of hosts; an
+// error is returned if any host failed.
+func BulkCreateHosts(ctx context.Context, c Client, inventoryID int, hosts []*Host, opts BulkOptions) ([]*BulkHostResult, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultBulkHostCreateChunkSize
+	}
+	results := make([]*BulkHostResult, len(hosts))
+	for i, h := range hosts {
+		results[i] = &BulkHostResult{Name: h.Name, Err: context.Canceled}
+	}
+	var mu sync.Mutex
+	noBulk := false
+	runChunks(ctx, len(hosts), opts, func(start, end int) {
+		mu.Lock()
+		fallback := noBulk
+		mu.Unlock()
+		if !fallback {
+			err := bulkCreateHostsChunk(ctx, c, inventoryID, hosts[start:end], results[start:end])
+			if !isNotFound(err) {
+				return
+			}
+			mu.Lock()
+			noBulk = true
+			mu.Unlock()
+		}
+		for i := start; i < end; i++ {
+			h := *hosts[i]
+			h.Inventory = inventoryID
+			results[i].Err = CreateHost(ctx, c, &h)
+			results[i].ID = h.ID
+		}
+	})
+	return results, bulkResultsErr(results)
+}
+
+func bulkCreateHostsChunk(ctx context.Context, c Client, inventoryID int, hosts []*Host, results []*BulkHostResult) error {
+	input := bulkHostCreateInput{Inventory: inventoryID}
+	for _, h := range hosts {
+		input.Hosts = append(input.Hosts, &bulkHost{
+			Name:        h.Name,
+			Description: h.Description,
+			Enabled:     h.Enabled,
+			InstanceID:  h.InstanceID,
+			Variables:   h.Variables,
+		})
+	}
+	output := bulkHostCreateOutput{}
+	err := post(ctx, c, ObjectKey{Resource: "bulk", Action: "host_create"}, &input, &output, []int{http.StatusCreated, http.StatusOK})
+	ids := map[string]int{}
+	for _, h := range output.Hosts {
+		ids[h.Name] = h.ID
+	}
+	for _, r := range results {
+		r.ID, r.Err = ids[r.Name], err
+		if err == nil && r.ID == 0 {
+			r.Err = fmt.Errorf("host %q missing in bulk response", r.Name)
+		}
+	}
+	return err
+}
+
+// BulkDeleteHosts deletes the hosts with the given ids through the
+// bulk/host_delete/ endpoint, falling back to deleting them one by one on
+// AWX versions without it. The results are in the order of hostIDs; an error
+// is returned if any host failed.
+func BulkDeleteHosts(ctx context.Context, c Client, hostIDs []int, opts BulkOptions) ([]*BulkHostResult, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultBulkHostDeleteChunkSize
+	}
+	results := make([]*BulkHostResult, len(hostIDs))
+	for i, id := range hostIDs {
+		results[i] = &BulkHostResult{ID: id, Err: context.Canceled}
+	}
+	var mu sync.Mutex
+	noBulk := false
+	runChunks(ctx, len(hostIDs), opts, func(start, end int) {
+		mu.Lock()
+		fallback := noBulk
+		mu.Unlock()
+		if !fallback {
+			input := bulkHostDeleteInput{Hosts: hostIDs[start:end]}
+			err := post(ctx, c, ObjectKey{Resource: "bulk", Action: "host_delete"}, &input, &struct{}{},
+				[]int{http.StatusCreated, http.StatusOK, http.StatusNoContent})
+			if !isNotFound(err) {
+				for _, r := range results[start:end] {
+					r.Err = err
+				}
+				return
+			}
+			mu.Lock()
+			noBulk = true
+			mu.Unlock()
+		}
+		for _, r := range results[start:end] {
+			r.Err = DeleteHost(ctx, c, r.ID)
+		}
+	})
+	return results, bulkResultsErr(results)
+}
@@ -0,0 +1,116 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkCreateHosts(t *testing.T) {
+	var mu sync.Mutex
+	var chunks []int
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bulk/host_create/", func(w http.ResponseWriter, r *http.Request) {
+		var received bulkHostCreateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, received.Inventory)
+		mu.Lock()
+		chunks = append(chunks, len(received.Hosts))
+		mu.Unlock()
+		output := bulkHostCreateOutput{}
+		for _, h := range received.Hosts {
+			id, err := strconv.Atoi(h.Name[len("web"):])
+			assert.NoError(t, err)
+			output.Hosts = append(output.Hosts, &Host{ID: 100 + id, Name: h.Name})
+		}
+		w.WriteHeader(http.StatusCreated)
+		err = json.NewEncoder(w).Encode(output)
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	var hosts []*Host
+	for i := range 5 {
+		hosts = append(hosts, &Host{Name: "web" + strconv.Itoa(i), Enabled: true})
+	}
+	results, err := BulkCreateHosts(context.Background(), client, 3, hosts, BulkOptions{ChunkSize: 2, Concurrency: 2})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{2, 2, 1}, chunks)
+	for i, r := range results {
+		assert.Equal(t, "web"+strconv.Itoa(i), r.Name)
+		assert.Equal(t, 100+i, r.ID)
+		assert.NoError(t, r.Err)
+	}
+}
+
+func TestBulkCreateHostsFallback(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bulk/host_create/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"detail": "Not found."}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /inventories/3/hosts/", func(w http.ResponseWriter, r *http.Request) {
+		var received Host
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		if received.Name == "broken" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err = w.Write([]byte(`{"__all__": ["Host with this Name and Inventory already exists."]}`))
+			assert.NoError(t, err)
+			return
+		}
+		received.ID = 7
+		w.WriteHeader(http.StatusCreated)
+		err = json.NewEncoder(w).Encode(received)
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	results, err := BulkCreateHosts(context.Background(), client, 3, []*Host{
+		{Name: "web01"},
+		{Name: "broken"},
+	}, BulkOptions{})
+	assert.ErrorContains(t, err, "1 of 2 hosts failed")
+	assert.Equal(t, 7, results[0].ID)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorContains(t, results[1].Err, "already exists")
+}
@@ -0,0 +1,105 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// BulkJobLaunchInput represents the input of the BulkLaunchJobs method.
+type BulkJobLaunchInput struct {
+	Name         string          `json:"name,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	Organization int             `json:"organization,omitempty"`
+	Inventory    int             `json:"inventory,omitempty"`
+	Limit        string          `json:"limit,omitempty"`
+	SCMBranch    string          `json:"scm_branch,omitempty"`
+	ExtraVars    map[string]any  `json:"extra_vars,omitempty"`
+	Jobs         []*BulkJobInput `json:"jobs"`
+}
+
+// BulkJobInput represents a single job of a bulk job launch.
+type BulkJobInput struct {
+	UnifiedJobTemplate int            `json:"unified_job_template"`
+	Identifier         string         `json:"identifier,omitempty"`
+	ExtraData          map[string]any `json:"extra_data,omitempty"`
+	Inventory          int            `json:"inventory,omitempty"`
+	Limit              string         `json:"limit,omitempty"`
+	Credentials        []int          `json:"credentials,omitempty"`
+	SCMBranch          string         `json:"scm_branch,omitempty"`
+	JobType            string         `json:"job_type,omitempty"`
+	JobTags            string         `json:"job_tags,omitempty"`
+	SkipTags           string         `json:"skip_tags,omitempty"`
+	Verbosity          *int           `json:"verbosity,omitempty"`
+	DiffMode           *bool          `json:"diff_mode,omitempty"`
+}
+
+// BulkJobReport represents the aggregated outcome of a bulk job launch.
+type BulkJobReport struct {
+	WorkflowJob *WorkflowJob
+	// Jobs contains the summary of every spawned job, nil for jobs which did
+	// not start.
+	Jobs      []*JobSummary
+	Succeeded int
+	Failed    int
+}
+
+// Success reports whether every job of the batch succeeded.
+func (r *BulkJobReport) Success() bool {
+	return r.Failed == 0 && r.Succeeded == len(r.Jobs)
+}
+
+// BulkLaunchJobs launches all jobs of input in a single request through the
+// bulk/job_launch/ endpoint. AWX runs them as a workflow job, which is returned.
+func BulkLaunchJobs(ctx context.Context, c Client, input *BulkJobLaunchInput) (*WorkflowJob, error) {
+	wj := &WorkflowJob{}
+	err := post(ctx, c, ObjectKey{Resource: "bulk", Action: "job_launch"}, input, wj, []int{http.StatusCreated, http.StatusOK})
+	if err != nil {
+		return nil, err
+	}
+	return wj, nil
+}
+
+// WaitBulkJobs waits until the workflow job of a bulk job launch finished,
+// polling every interval, and reports the outcome of its jobs.
+func WaitBulkJobs(ctx context.Context, c Client, workflowJobID int, interval time.Duration) (*BulkJobReport, error) {
+	wj, err := WaitWorkflowJob(ctx, c, workflowJobID, interval, nil)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := ListWorkflowJobNodes(ctx, c, workflowJobID)
+	if err != nil {
+		return nil, err
+	}
+	report := &BulkJobReport{WorkflowJob: wj}
+	for _, n := range nodes {
+		job := n.SummaryFields.Job
+		report.Jobs = append(report.Jobs, job)
+		switch {
+		case job == nil:
+		case job.Status == JobStatusSuccessful:
+			report.Succeeded++
+		case IsFinishedJobStatus(job.Status):
+			report.Failed++
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,80 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkLaunchJobs(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /bulk/job_launch/", func(w http.ResponseWriter, r *http.Request) {
+		var received BulkJobLaunchInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(received.Jobs))
+		assert.Equal(t, "eu-de-1", received.Jobs[1].ExtraData["region"])
+		writeJSON(w, http.StatusCreated, `{"id": 20, "name": "patch", "type": "workflow_job", "status": "pending"}`)
+	})
+	mux.HandleFunc("GET /workflow_jobs/20/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"id": 20, "name": "patch", "type": "workflow_job", "status": "failed", "failed": true}`)
+	})
+	mux.HandleFunc("GET /workflow_jobs/20/workflow_nodes/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"results": [
+			{"id": 1, "job": 31, "summary_fields": {"job": {"id": 31, "type": "job", "status": "successful"}}},
+			{"id": 2, "job": 32, "summary_fields": {"job": {"id": 32, "type": "job", "status": "failed", "failed": true}}}
+		]}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	wj, err := BulkLaunchJobs(context.Background(), client, &BulkJobLaunchInput{
+		Name: "patch",
+		Jobs: []*BulkJobInput{
+			{UnifiedJobTemplate: 4, Limit: "eu-nl-1"},
+			{UnifiedJobTemplate: 4, Limit: "eu-de-1", ExtraData: map[string]any{"region": "eu-de-1"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, wj.ID)
+
+	report, err := WaitBulkJobs(context.Background(), client, wj.ID, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 1, report.Failed)
+	assert.False(t, report.Success())
+}
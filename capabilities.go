@@ -0,0 +1,73 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// UserCapabilities represents what the authenticated user may do with an
+// object, as reported in summary_fields.user_capabilities. Which
+// capabilities are reported depends on the kind of object.
+type UserCapabilities struct {
+	Edit     bool `json:"edit"`
+	Delete   bool `json:"delete"`
+	Start    bool `json:"start"`
+	Schedule bool `json:"schedule"`
+	Copy     bool `json:"copy"`
+	Use      bool `json:"use"`
+	Adhoc    bool `json:"adhoc"`
+}
+
+// ObjectSummaryFields represents the summary fields shared by all objects.
+// AWX computes them, so models hold them as a pointer. It is nil for new
+// objects, which keeps summary_fields out of create requests.
+type ObjectSummaryFields struct {
+	UserCapabilities UserCapabilities `json:"user_capabilities"`
+}
+
+// GetUserCapabilities retrieves what the authenticated user may do with the
+// object at key, e.g. ObjectKey{Resource: "job_templates", ResourceID: "1"}.
+func GetUserCapabilities(ctx context.Context, c Client, key ObjectKey) (*UserCapabilities, error) {
+	obj := struct {
+		SummaryFields ObjectSummaryFields `json:"summary_fields"`
+	}{}
+	if err := c.Get(ctx, key, &obj, nil); err != nil {
+		return nil, err
+	}
+	return &obj.SummaryFields.UserCapabilities, nil
+}
+
+// CanLaunch reports whether the authenticated user may launch the job
+// template with the given id. A template the user cannot see at all cannot
+// be launched either.
+func CanLaunch(ctx context.Context, c Client, templateID int) (bool, error) {
+	capabilities, err := GetUserCapabilities(ctx, c, ObjectKey{Resource: "job_templates", ResourceID: strconv.Itoa(templateID)})
+	var awxErr *Error
+	if errors.As(err, &awxErr) && (awxErr.StatusCode == http.StatusForbidden || awxErr.StatusCode == http.StatusNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return capabilities.Start, nil
+}
@@ -0,0 +1,68 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanLaunch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /job_templates/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.PathValue("id") {
+		case "1":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"id": 1, "name": "deploy", "summary_fields": {"user_capabilities": {"edit": false, "delete": false, "start": true, "schedule": true, "copy": false}}}`))
+			assert.NoError(t, err)
+		case "2":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"id": 2, "name": "read only", "summary_fields": {"user_capabilities": {"start": false}}}`))
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, err := w.Write([]byte(`{"detail": "You do not have permission to perform this action."}`))
+			assert.NoError(t, err)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	for id, expected := range map[int]bool{1: true, 2: false, 3: false} {
+		ok, err := CanLaunch(context.Background(), client, id)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, ok, "template %d", id)
+	}
+
+	tpl := JobTemplate{}
+	err = client.Get(context.Background(), ObjectKey{Resource: "job_templates", ResourceID: "1"}, &tpl, nil)
+	assert.NoError(t, err)
+	assert.True(t, tpl.SummaryFields.UserCapabilities.Schedule)
+	assert.False(t, tpl.SummaryFields.UserCapabilities.Edit)
+}
@@ -0,0 +1,151 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// CredentialInputSourceList represents the output of the ListCredentialInputSources method.
+type CredentialInputSourceList struct {
+	ListGetResponse
+	Results []*CredentialInputSource `json:"results,omitempty"`
+}
+
+// CredentialInputSource links an input field of a target credential to an
+// external secret, looked up through a source credential such as HashiCorp
+// Vault.
+type CredentialInputSource struct {
+	ID               int            `json:"id,omitempty"`
+	Description      string         `json:"description"`
+	URL              string         `json:"url,omitempty"`
+	Type             string         `json:"type,omitempty"`
+	Modified         string         `json:"modified,omitempty"`
+	Created          string         `json:"created,omitempty"`
+	InputFieldName   string         `json:"input_field_name"`
+	Metadata         map[string]any `json:"metadata"`
+	TargetCredential int            `json:"target_credential"`
+	SourceCredential int            `json:"source_credential"`
+}
+
+// HashiVaultSecretMetadata represents the lookup metadata of the HashiCorp
+// Vault Secret Lookup credential type.
+type HashiVaultSecretMetadata struct {
+	SecretPath    string `json:"secret_path"`
+	SecretKey     string `json:"secret_key"`
+	SecretBackend string `json:"secret_backend,omitempty"`
+	SecretVersion string `json:"secret_version,omitempty"`
+	AuthPath      string `json:"auth_path,omitempty"`
+}
+
+// Map returns the metadata as expected by CredentialInputSource.Metadata.
+func (m HashiVaultSecretMetadata) Map() map[string]any {
+	out := map[string]any{
+		"secret_path": m.SecretPath,
+		"secret_key":  m.SecretKey,
+	}
+	for k, v := range map[string]string{
+		"secret_backend": m.SecretBackend,
+		"secret_version": m.SecretVersion,
+		"auth_path":      m.AuthPath,
+	} {
+		if v != "" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// VerifyCredentialInput represents the input of the VerifyCredential method.
+type VerifyCredentialInput struct {
+	// Inputs overrides the stored inputs of the credential, e.g. to test a
+	// new token before saving it.
+	Inputs map[string]any `json:"inputs,omitempty"`
+	// Metadata is the lookup metadata to test, e.g. a secret path and key.
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+const credentialInputSourcesResource = "credential_input_sources"
+
+// GetCredentialInputSource retrieves the credential input source with the given id.
+func GetCredentialInputSource(ctx context.Context, c Client, id int) (*CredentialInputSource, error) {
+	return getByID[CredentialInputSource](ctx, c, credentialInputSourcesResource, id)
+}
+
+// ListCredentialInputSources retrieves the input sources of the credential
+// with the given target id.
+func ListCredentialInputSources(ctx context.Context, c Client, targetCredentialID int) ([]*CredentialInputSource, error) {
+	return listAll[CredentialInputSource](ctx, c, ObjectKey{
+		Resource:   credentialsResource,
+		ResourceID: strconv.Itoa(targetCredentialID),
+		Action:     "input_sources",
+	}, nil)
+}
+
+// CreateCredentialInputSource creates src in AWX and updates it with the
+// response of the server.
+func CreateCredentialInputSource(ctx context.Context, c Client, src *CredentialInputSource) error {
+	return c.Create(ctx, ObjectKey{Resource: credentialInputSourcesResource}, src, nil)
+}
+
+// UpdateCredentialInputSource updates src in AWX.
+func UpdateCredentialInputSource(ctx context.Context, c Client, src *CredentialInputSource) error {
+	return c.Update(ctx, idKey(credentialInputSourcesResource, src.ID), src, nil)
+}
+
+// DeleteCredentialInputSource deletes the credential input source with the given id.
+func DeleteCredentialInputSource(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(credentialInputSourcesResource, id), nil)
+}
+
+// LinkCredentialInput makes the input field of the target credential look up
+// its value through the source credential, replacing an existing link of
+// that field.
+func LinkCredentialInput(ctx context.Context, c Client, targetCredentialID int, field string, sourceCredentialID int, metadata map[string]any) (*CredentialInputSource, error) {
+	sources, err := ListCredentialInputSources(ctx, c, targetCredentialID)
+	if err != nil {
+		return nil, err
+	}
+	src := &CredentialInputSource{
+		InputFieldName:   field,
+		Metadata:         metadata,
+		TargetCredential: targetCredentialID,
+		SourceCredential: sourceCredentialID,
+	}
+	for _, existing := range sources {
+		if existing.InputFieldName == field {
+			src.ID = existing.ID
+			src.Description = existing.Description
+			return src, UpdateCredentialInputSource(ctx, c, src)
+		}
+	}
+	return src, CreateCredentialInputSource(ctx, c, src)
+}
+
+// VerifyCredential tests the lookup of the external secret credential with the
+// given id. AWX answers with an error describing the failed lookup.
+func VerifyCredential(ctx context.Context, c Client, id int, input *VerifyCredentialInput) error {
+	if input == nil {
+		input = &VerifyCredentialInput{}
+	}
+	key := ObjectKey{Resource: credentialsResource, ResourceID: strconv.Itoa(id), Action: "test"}
+	return post(ctx, c, key, input, &struct{}{}, []int{http.StatusAccepted, http.StatusOK})
+}
@@ -0,0 +1,79 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkCredentialInput(t *testing.T) {
+	var created CredentialInputSource
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /credentials/21/input_sources/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 0, "results": []}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /credential_input_sources/", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&created)
+		assert.NoError(t, err)
+		created.ID = 2
+		w.WriteHeader(http.StatusCreated)
+		err = json.NewEncoder(w).Encode(created)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /credentials/30/test/", func(w http.ResponseWriter, r *http.Request) {
+		var received VerifyCredentialInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		if received.Metadata["secret_path"] == "missing" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err = w.Write([]byte(`{"inputs": "HTTP 404: secret not found"}`))
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	metadata := HashiVaultSecretMetadata{SecretPath: "/kv/awx", SecretKey: "password"}.Map()
+	src, err := LinkCredentialInput(context.Background(), client, 21, "password", 30, metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, src.ID)
+	assert.Equal(t, "password", created.InputFieldName)
+	assert.Equal(t, map[string]any{"secret_path": "/kv/awx", "secret_key": "password"}, created.Metadata)
+
+	err = VerifyCredential(context.Background(), client, 30, &VerifyCredentialInput{Metadata: metadata})
+	assert.NoError(t, err)
+	err = VerifyCredential(context.Background(), client, 30, &VerifyCredentialInput{Metadata: map[string]any{"secret_path": "missing"}})
+	assert.ErrorContains(t, err, "secret not found")
+}
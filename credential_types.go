@@ -0,0 +1,177 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// Kinds of credential types. Custom credential types must be of kind cloud
+// or net.
+const (
+	CredentialTypeKindSSH          = "ssh"
+	CredentialTypeKindVault        = "vault"
+	CredentialTypeKindNet          = "net"
+	CredentialTypeKindSCM          = "scm"
+	CredentialTypeKindCloud        = "cloud"
+	CredentialTypeKindRegistry     = "registry"
+	CredentialTypeKindToken        = "token"
+	CredentialTypeKindInsights     = "insights"
+	CredentialTypeKindExternal     = "external"
+	CredentialTypeKindKubernetes   = "kubernetes"
+	CredentialTypeKindGalaxy       = "galaxy"
+	CredentialTypeKindCryptography = "cryptography"
+)
+
+// CredentialTypeList represents the output of the ListCredentialTypes method.
+type CredentialTypeList struct {
+	ListGetResponse
+	Results []*CredentialType `json:"results,omitempty"`
+}
+
+// CredentialType represents the output of the GetCredentialTypes method.
+type CredentialType struct {
+	ID          int                     `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	URL         string                  `json:"url,omitempty"`
+	Type        string                  `json:"type,omitempty"`
+	Modified    string                  `json:"modified,omitempty"`
+	Created     string                  `json:"created,omitempty"`
+	Kind        string                  `json:"kind"`
+	Namespace   string                  `json:"namespace,omitempty"`
+	Managed     bool                    `json:"managed,omitempty"`
+	Inputs      CredentialTypeInputs    `json:"inputs"`
+	Injectors   CredentialTypeInjectors `json:"injectors"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// CredentialTypeInputs represents the input definition of a credential type.
+type CredentialTypeInputs struct {
+	Fields   []*CredentialTypeField `json:"fields,omitempty"`
+	Metadata []*CredentialTypeField `json:"metadata,omitempty"`
+	Required []string               `json:"required,omitempty"`
+}
+
+// CredentialTypeField represents a single input field of a credential type.
+type CredentialTypeField struct {
+	ID        string   `json:"id"`
+	Label     string   `json:"label"`
+	Type      string   `json:"type,omitempty"` // string or boolean
+	Secret    bool     `json:"secret,omitempty"`
+	Multiline bool     `json:"multiline,omitempty"`
+	HelpText  string   `json:"help_text,omitempty"`
+	Format    string   `json:"format,omitempty"`
+	Choices   []string `json:"choices,omitempty"`
+	Default   any      `json:"default,omitempty"`
+}
+
+// CredentialTypeInjectors represents how a credential type exposes its
+// inputs to jobs. The values are Jinja templates referring to the input
+// fields, e.g. "{{ api_token }}".
+type CredentialTypeInjectors struct {
+	Env       map[string]string `json:"env,omitempty"`
+	ExtraVars map[string]string `json:"extra_vars,omitempty"`
+	File      map[string]string `json:"file,omitempty"`
+}
+
+// ListCredentialTypesInput represents the input of the ListCredentialTypes method.
+type ListCredentialTypesInput struct {
+	ID        string `schema:"id,omitempty"`
+	Name      string `schema:"name,omitempty"`
+	Kind      string `schema:"kind,omitempty"`
+	Namespace string `schema:"namespace,omitempty"`
+	Managed   *bool  `schema:"managed,omitempty"`
+}
+
+const credentialTypesResource = "credential_types"
+
+// GetCredentialType retrieves the credential type with the given id.
+func GetCredentialType(ctx context.Context, c Client, id int) (*CredentialType, error) {
+	return getByID[CredentialType](ctx, c, credentialTypesResource, id)
+}
+
+// ListCredentialTypes retrieves all credential types matching input.
+func ListCredentialTypes(ctx context.Context, c Client, input *ListCredentialTypesInput) ([]*CredentialType, error) {
+	return listAll[CredentialType](ctx, c, ObjectKey{Resource: credentialTypesResource}, input)
+}
+
+// GetManagedCredentialType retrieves the built-in credential type with the
+// given namespace, e.g. "ssh" or "openstack".
+func GetManagedCredentialType(ctx context.Context, c Client, namespace string) (*CredentialType, error) {
+	managed := true
+	types, err := ListCredentialTypes(ctx, c, &ListCredentialTypesInput{Namespace: namespace, Managed: &managed})
+	if err != nil {
+		return nil, err
+	}
+	if len(types) != 1 {
+		return nil, fmt.Errorf("found %d managed credential types with namespace %q", len(types), namespace)
+	}
+	return types[0], nil
+}
+
+// CreateCredentialType creates the custom credential type ct in AWX and
+// updates it with the response of the server.
+func CreateCredentialType(ctx context.Context, c Client, ct *CredentialType) error {
+	if err := ct.Validate(); err != nil {
+		return err
+	}
+	return c.Create(ctx, ObjectKey{Resource: credentialTypesResource}, ct, nil)
+}
+
+// UpdateCredentialType updates the custom credential type ct in AWX.
+func UpdateCredentialType(ctx context.Context, c Client, ct *CredentialType) error {
+	if err := ct.Validate(); err != nil {
+		return err
+	}
+	return c.Update(ctx, idKey(credentialTypesResource, ct.ID), ct, nil)
+}
+
+// DeleteCredentialType deletes the credential type with the given id.
+func DeleteCredentialType(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(credentialTypesResource, id), nil)
+}
+
+// Validate checks a custom credential type before it is sent to AWX.
+func (ct *CredentialType) Validate() error {
+	if ct.Kind != CredentialTypeKindCloud && ct.Kind != CredentialTypeKindNet {
+		return fmt.Errorf("credential type %q: custom credential types must be of kind cloud or net", ct.Name)
+	}
+	ids := map[string]bool{}
+	for _, f := range ct.Inputs.Fields {
+		if f.ID == "" || f.Label == "" {
+			return fmt.Errorf("credential type %q: input fields need an id and a label", ct.Name)
+		}
+		if ids[f.ID] {
+			return fmt.Errorf("credential type %q: duplicate input field %q", ct.Name, f.ID)
+		}
+		if f.Type != "" && f.Type != "string" && f.Type != "boolean" {
+			return fmt.Errorf("credential type %q: input field %q has unknown type %q", ct.Name, f.ID, f.Type)
+		}
+		ids[f.ID] = true
+	}
+	for _, id := range ct.Inputs.Required {
+		if !ids[id] {
+			return fmt.Errorf("credential type %q: required input field %q is not defined", ct.Name, id)
+		}
+	}
+	return nil
+}
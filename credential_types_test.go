@@ -0,0 +1,49 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialTypeValidate(t *testing.T) {
+	ct := CredentialType{
+		Name: "API token",
+		Kind: CredentialTypeKindCloud,
+		Inputs: CredentialTypeInputs{
+			Fields: []*CredentialTypeField{
+				{ID: "api_url", Label: "API URL", Type: "string"},
+				{ID: "api_token", Label: "API Token", Type: "string", Secret: true},
+			},
+			Required: []string{"api_url", "api_token"},
+		},
+		Injectors: CredentialTypeInjectors{
+			Env: map[string]string{"API_TOKEN": "{{ api_token }}"},
+		},
+	}
+	assert.NoError(t, ct.Validate())
+
+	ct.Inputs.Required = append(ct.Inputs.Required, "username")
+	assert.ErrorContains(t, ct.Validate(), `required input field "username" is not defined`)
+
+	ct.Kind = CredentialTypeKindSSH
+	assert.ErrorContains(t, ct.Validate(), "must be of kind cloud or net")
+}
@@ -0,0 +1,306 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CredentialList represents the output of the ListCredentials method.
+type CredentialList struct {
+	ListGetResponse
+	Results []*Credential `json:"results,omitempty"`
+}
+
+// Credential represents the output of the GetCredentials method.
+type Credential struct {
+	ID             int            `json:"id,omitempty"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	URL            string         `json:"url,omitempty"`
+	Type           string         `json:"type,omitempty"`
+	Modified       string         `json:"modified,omitempty"`
+	Created        string         `json:"created,omitempty"`
+	Organization   *int           `json:"organization"`
+	User           *int           `json:"user,omitempty"`
+	Team           *int           `json:"team,omitempty"`
+	CredentialType int            `json:"credential_type"`
+	Kind           string         `json:"kind,omitempty"`
+	Cloud          bool           `json:"cloud,omitempty"`
+	Managed        bool           `json:"managed,omitempty"`
+	Inputs         map[string]any `json:"inputs"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListCredentialsInput represents the input of the ListCredentials method.
+type ListCredentialsInput struct {
+	ID             string `schema:"id,omitempty"`
+	Name           string `schema:"name,omitempty"`
+	CredentialType int    `schema:"credential_type,omitempty"`
+	Organization   int    `schema:"organization,omitempty"`
+}
+
+// EncryptedValue is returned by AWX in place of secret inputs. Sending it back
+// keeps the stored secret.
+const EncryptedValue = "$encrypted$"
+
+// Secret represents a secret credential input. Its value is masked when
+// formatted, so that secrets do not end up in logs, but kept when encoded as
+// JSON for AWX.
+type Secret string
+
+// String returns a masked representation of the secret.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return "********"
+}
+
+// GoString returns a masked representation of the secret.
+func (s Secret) GoString() string {
+	return strconv.Quote(s.String())
+}
+
+// Value returns the plain secret.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// CredentialInputs is implemented by the typed inputs of the managed
+// credential types.
+type CredentialInputs interface {
+	// CredentialTypeNamespace returns the namespace of the managed
+	// credential type the inputs belong to.
+	CredentialTypeNamespace() string
+}
+
+// MachineInputs represents the inputs of a machine (SSH) credential.
+type MachineInputs struct {
+	Username         string `json:"username,omitempty"`
+	Password         Secret `json:"password,omitempty"`
+	SSHKeyData       Secret `json:"ssh_key_data,omitempty"`
+	SSHPublicKeyData string `json:"ssh_public_key_data,omitempty"`
+	SSHKeyUnlock     Secret `json:"ssh_key_unlock,omitempty"`
+	BecomeMethod     string `json:"become_method,omitempty"`
+	BecomeUsername   string `json:"become_username,omitempty"`
+	BecomePassword   Secret `json:"become_password,omitempty"`
+}
+
+// CredentialTypeNamespace implements the CredentialInputs interface.
+func (MachineInputs) CredentialTypeNamespace() string { return "ssh" }
+
+// VaultInputs represents the inputs of an Ansible vault credential.
+type VaultInputs struct {
+	VaultPassword Secret `json:"vault_password"`
+	VaultID       string `json:"vault_id,omitempty"`
+}
+
+// CredentialTypeNamespace implements the CredentialInputs interface.
+func (VaultInputs) CredentialTypeNamespace() string { return "vault" }
+
+// SourceControlInputs represents the inputs of a source control credential.
+type SourceControlInputs struct {
+	Username     string `json:"username,omitempty"`
+	Password     Secret `json:"password,omitempty"`
+	SSHKeyData   Secret `json:"ssh_key_data,omitempty"`
+	SSHKeyUnlock Secret `json:"ssh_key_unlock,omitempty"`
+}
+
+// CredentialTypeNamespace implements the CredentialInputs interface.
+func (SourceControlInputs) CredentialTypeNamespace() string { return "scm" }
+
+// OpenStackInputs represents the inputs of an OpenStack credential.
+type OpenStackInputs struct {
+	Host              string `json:"host"`
+	Username          string `json:"username"`
+	Password          Secret `json:"password"`
+	Project           string `json:"project"`
+	ProjectDomainName string `json:"project_domain_name,omitempty"`
+	Domain            string `json:"domain,omitempty"`
+	Region            string `json:"region,omitempty"`
+	VerifySSL         bool   `json:"verify_ssl"`
+}
+
+// CredentialTypeNamespace implements the CredentialInputs interface.
+func (OpenStackInputs) CredentialTypeNamespace() string { return "openstack" }
+
+// KubernetesBearerTokenInputs represents the inputs of an OpenShift or
+// Kubernetes API bearer token credential.
+type KubernetesBearerTokenInputs struct {
+	Host        string `json:"host"`
+	BearerToken Secret `json:"bearer_token"`
+	VerifySSL   bool   `json:"verify_ssl"`
+	SSLCACert   string `json:"ssl_ca_cert,omitempty"`
+}
+
+// CredentialTypeNamespace implements the CredentialInputs interface.
+func (KubernetesBearerTokenInputs) CredentialTypeNamespace() string { return "kubernetes_bearer_token" }
+
+// SetInputs sets the inputs of the credential from typed inputs. Secret
+// fields stay Secret values in the inputs map, so that printing the
+// credential does not reveal them.
+func (c *Credential) SetInputs(inputs CredentialInputs) error {
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		return err
+	}
+	m := map[string]any{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	v := reflect.Indirect(reflect.ValueOf(inputs))
+	for i := 0; v.Kind() == reflect.Struct && i < v.NumField(); i++ {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if s, ok := v.Field(i).Interface().(Secret); ok {
+			if _, set := m[name]; set {
+				m[name] = s
+			}
+		}
+	}
+	c.Inputs = m
+	return nil
+}
+
+// DecodeInputs decodes the inputs of the credential into out, which must be
+// a pointer to typed inputs such as *MachineInputs. Secrets are returned by
+// AWX as EncryptedValue.
+func (c *Credential) DecodeInputs(out CredentialInputs) error {
+	data, err := json.Marshal(c.Inputs)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+const credentialsResource = "credentials"
+
+// GetCredential retrieves the credential with the given id.
+func GetCredential(ctx context.Context, c Client, id int) (*Credential, error) {
+	return getByID[Credential](ctx, c, credentialsResource, id)
+}
+
+// GetCredentialByName retrieves the credential with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several credentials match.
+func GetCredentialByName(ctx context.Context, c Client, name, organization string) (*Credential, error) {
+	return GetByName[Credential](ctx, c, credentialsResource, name, organization)
+}
+
+// ListCredentials retrieves all credentials matching input.
+func ListCredentials(ctx context.Context, c Client, input *ListCredentialsInput) ([]*Credential, error) {
+	return listAll[Credential](ctx, c, ObjectKey{Resource: credentialsResource}, input)
+}
+
+// CreateCredential creates cred in AWX and updates it with the response of the server.
+func CreateCredential(ctx context.Context, c Client, cred *Credential) error {
+	return c.Create(ctx, ObjectKey{Resource: credentialsResource}, cred, nil)
+}
+
+// CreateTypedCredential creates a credential of the managed credential type
+// matching inputs and updates cred with the response of the server.
+func CreateTypedCredential(ctx context.Context, c Client, cred *Credential, inputs CredentialInputs) error {
+	ct, err := GetManagedCredentialType(ctx, c, inputs.CredentialTypeNamespace())
+	if err != nil {
+		return err
+	}
+	cred.CredentialType = ct.ID
+	if err = cred.SetInputs(inputs); err != nil {
+		return err
+	}
+	return CreateCredential(ctx, c, cred)
+}
+
+// UpdateCredential updates cred in AWX. Secret inputs set to EncryptedValue
+// keep their stored value.
+func UpdateCredential(ctx context.Context, c Client, cred *Credential) error {
+	return c.Update(ctx, idKey(credentialsResource, cred.ID), cred, nil)
+}
+
+// DeleteCredential deletes the credential with the given id.
+func DeleteCredential(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(credentialsResource, id), nil)
+}
+
+// credentialsKey returns the key of the credentials sub list of parent, e.g.
+// job_templates/{id}/credentials/, workflow_job_template_nodes/{id}/credentials/
+// or schedules/{id}/credentials/.
+func credentialsKey(parent ObjectKey) ObjectKey {
+	parent.Action = "credentials"
+	return parent
+}
+
+// ListAttachedCredentials retrieves all credentials attached to parent.
+func ListAttachedCredentials(ctx context.Context, c Client, parent ObjectKey) ([]*Credential, error) {
+	return listAll[Credential](ctx, c, credentialsKey(parent), nil)
+}
+
+// AttachCredential attaches the credential to parent.
+func AttachCredential(ctx context.Context, c Client, parent ObjectKey, credentialID int) error {
+	return Associate(ctx, c, credentialsKey(parent), credentialID)
+}
+
+// DetachCredential detaches the credential from parent.
+func DetachCredential(ctx context.Context, c Client, parent ObjectKey, credentialID int) error {
+	return Disassociate(ctx, c, credentialsKey(parent), credentialID)
+}
+
+// SetCredentials makes the credentials attached to parent exactly
+// credentialIDs. Only the missing credentials are attached and only the
+// superfluous ones are detached. Detaching happens first, since AWX rejects
+// two credentials of the same type on a job template. On error, attached and
+// detached hold the changes made before the failure.
+func SetCredentials(ctx context.Context, c Client, parent ObjectKey, credentialIDs []int) (attached, detached []int, err error) {
+	current, err := ListAttachedCredentials(ctx, c, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	currentIDs := make([]int, 0, len(current))
+	var toDetach, toAttach []int
+	for _, cred := range current {
+		currentIDs = append(currentIDs, cred.ID)
+		if !slices.Contains(credentialIDs, cred.ID) {
+			toDetach = append(toDetach, cred.ID)
+		}
+	}
+	for _, id := range credentialIDs {
+		if !slices.Contains(currentIDs, id) && !slices.Contains(toAttach, id) {
+			toAttach = append(toAttach, id)
+		}
+	}
+	for _, id := range toDetach {
+		if err = DetachCredential(ctx, c, parent, id); err != nil {
+			return attached, detached, err
+		}
+		detached = append(detached, id)
+	}
+	for _, id := range toAttach {
+		if err = AttachCredential(ctx, c, parent, id); err != nil {
+			return attached, detached, err
+		}
+		attached = append(attached, id)
+	}
+	return attached, detached, nil
+}
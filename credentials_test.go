@@ -0,0 +1,167 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCredentials(t *testing.T) {
+	var requests []AssociateInput
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /job_templates/1/credentials/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "200", r.URL.Query().Get("page_size"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{
+			"count": 2,
+			"results": [
+				{"id": 10, "name": "machine", "kind": "ssh"},
+				{"id": 11, "name": "vault", "kind": "vault"}
+			]
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /job_templates/1/credentials/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		requests = append(requests, received)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	attached, detached, err := SetCredentials(context.Background(), client, ObjectKey{
+		Resource:   "job_templates",
+		ResourceID: "1",
+	}, []int{10, 12})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{12}, attached)
+	assert.Equal(t, []int{11}, detached)
+	assert.Equal(t, []AssociateInput{
+		{ID: 11, Disassociate: true},
+		{ID: 12},
+	}, requests)
+}
+
+func TestSetCredentialsPartialFailure(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /job_templates/1/credentials/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 11, "name": "vault", "kind": "vault"}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /job_templates/1/credentials/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		if received.ID == 13 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	attached, detached, err := SetCredentials(context.Background(), client, ObjectKey{
+		Resource:   "job_templates",
+		ResourceID: "1",
+	}, []int{12, 13})
+	assert.Error(t, err)
+	assert.Equal(t, []int{12}, attached)
+	assert.Equal(t, []int{11}, detached)
+}
+
+func TestCreateTypedCredential(t *testing.T) {
+	var created map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /credential_types/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "openstack", r.URL.Query().Get("namespace"))
+		assert.Equal(t, "true", r.URL.Query().Get("managed"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 13, "name": "OpenStack", "kind": "cloud", "namespace": "openstack", "managed": true}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /credentials/", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&created)
+		assert.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{"id": 21, "name": "openstack", "credential_type": 13, "inputs": {"host": "https://identity", "password": "$encrypted$"}}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	inputs := OpenStackInputs{
+		Host:     "https://identity",
+		Username: "admin",
+		Password: "s3cr3t",
+		Project:  "infra",
+	}
+	assert.NotContains(t, fmt.Sprintf("%v %+v %#v", inputs, inputs, inputs), "s3cr3t")
+
+	cred := Credential{Name: "openstack"}
+	err = CreateTypedCredential(context.Background(), client, &cred, inputs)
+	assert.NoError(t, err)
+	assert.Equal(t, 13.0, created["credential_type"])
+	assert.Equal(t, "s3cr3t", created["inputs"].(map[string]any)["password"])
+	assert.Equal(t, 21, cred.ID)
+
+	decoded := OpenStackInputs{}
+	err = cred.DecodeInputs(&decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, Secret(EncryptedValue), decoded.Password)
+}
+
+func TestCredentialSetInputsMasksSecrets(t *testing.T) {
+	cred := Credential{}
+	err := cred.SetInputs(&MachineInputs{Username: "deploy", SSHKeyData: "-----BEGIN KEY-----"})
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy", cred.Inputs["username"])
+	assert.NotContains(t, fmt.Sprintf("%v", cred), "BEGIN KEY")
+	_, ok := cred.Inputs["password"]
+	assert.False(t, ok)
+}
@@ -0,0 +1,296 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field lookups supported by the AWX list filters.
+const (
+	LookupExact       = "exact"
+	LookupIExact      = "iexact"
+	LookupContains    = "contains"
+	LookupIContains   = "icontains"
+	LookupStartsWith  = "startswith"
+	LookupIStartsWith = "istartswith"
+	LookupEndsWith    = "endswith"
+	LookupIEndsWith   = "iendswith"
+	LookupRegex       = "regex"
+	LookupIRegex      = "iregex"
+	LookupGT          = "gt"
+	LookupGTE         = "gte"
+	LookupLT          = "lt"
+	LookupLTE         = "lte"
+	LookupIn          = "in"
+	LookupIsNull      = "isnull"
+)
+
+// Field represents a field to filter on. Fields of related objects are
+// separated by double underscores, e.g. "job_template__name", see Related.
+type Field string
+
+// Related returns the field reached by traversing the given relations, e.g.
+// Related("job_template", "organization", "name").
+func Related(path ...string) Field {
+	return Field(strings.Join(path, "__"))
+}
+
+// Condition represents a single filter of a list call, e.g.
+// name__icontains=deploy.
+type Condition struct {
+	Field  Field
+	Lookup string
+	Value  string
+	// Negate excludes the matching objects (not__ prefix).
+	Negate bool
+	// Any combines the condition with the other Any conditions by OR instead
+	// of AND (or__ prefix).
+	Any bool
+}
+
+// Key returns the query key of the condition.
+func (c Condition) Key() string {
+	key := string(c.Field)
+	if c.Lookup != "" && c.Lookup != LookupExact {
+		key += "__" + c.Lookup
+	}
+	if c.Negate {
+		key = "not__" + key
+	}
+	if c.Any {
+		key = "or__" + key
+	}
+	return key
+}
+
+// Not returns the negation of c.
+func Not(c Condition) Condition {
+	c.Negate = !c.Negate
+	return c
+}
+
+// Or returns c combined by OR with the other conditions passed through Or,
+// e.g. Or(Field("status").Eq("failed")), Or(Field("status").Eq("error")).
+func Or(c Condition) Condition {
+	c.Any = true
+	return c
+}
+
+// Lookup returns a condition applying lookup to the field.
+func (f Field) Lookup(lookup string, value any) Condition {
+	return Condition{Field: f, Lookup: lookup, Value: formatFilterValue(value)}
+}
+
+// Eq matches objects whose field equals value.
+func (f Field) Eq(value any) Condition { return f.Lookup(LookupExact, value) }
+
+// IExact matches objects whose field equals value, ignoring case.
+func (f Field) IExact(value string) Condition { return f.Lookup(LookupIExact, value) }
+
+// Contains matches objects whose field contains value.
+func (f Field) Contains(value string) Condition { return f.Lookup(LookupContains, value) }
+
+// IContains matches objects whose field contains value, ignoring case.
+func (f Field) IContains(value string) Condition { return f.Lookup(LookupIContains, value) }
+
+// StartsWith matches objects whose field starts with value.
+func (f Field) StartsWith(value string) Condition { return f.Lookup(LookupStartsWith, value) }
+
+// IStartsWith matches objects whose field starts with value, ignoring case.
+func (f Field) IStartsWith(value string) Condition { return f.Lookup(LookupIStartsWith, value) }
+
+// EndsWith matches objects whose field ends with value.
+func (f Field) EndsWith(value string) Condition { return f.Lookup(LookupEndsWith, value) }
+
+// IEndsWith matches objects whose field ends with value, ignoring case.
+func (f Field) IEndsWith(value string) Condition { return f.Lookup(LookupIEndsWith, value) }
+
+// Regex matches objects whose field matches the regular expression.
+func (f Field) Regex(expr string) Condition { return f.Lookup(LookupRegex, expr) }
+
+// IRegex matches objects whose field matches the regular expression, ignoring case.
+func (f Field) IRegex(expr string) Condition { return f.Lookup(LookupIRegex, expr) }
+
+// GT matches objects whose field is greater than value.
+func (f Field) GT(value any) Condition { return f.Lookup(LookupGT, value) }
+
+// GTE matches objects whose field is greater than or equal to value.
+func (f Field) GTE(value any) Condition { return f.Lookup(LookupGTE, value) }
+
+// LT matches objects whose field is less than value.
+func (f Field) LT(value any) Condition { return f.Lookup(LookupLT, value) }
+
+// LTE matches objects whose field is less than or equal to value.
+func (f Field) LTE(value any) Condition { return f.Lookup(LookupLTE, value) }
+
+// In matches objects whose field equals one of values.
+func (f Field) In(values ...any) Condition {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = formatFilterValue(v)
+	}
+	return Condition{Field: f, Lookup: LookupIn, Value: strings.Join(s, ",")}
+}
+
+// IsNull matches objects whose field is null, or not null if null is false.
+func (f Field) IsNull(null bool) Condition { return f.Lookup(LookupIsNull, null) }
+
+func formatFilterValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Filter builds the query of a list call from conditions, ordering, search
+// and page size. It can be passed as list options to any list call, e.g.
+//
+//	NewFilter(Related("job_template", "name").IContains("deploy")).
+//		Where(Field("status").In("failed", "error")).
+//		OrderBy("-finished")
+type Filter struct {
+	conditions []Condition
+	orderBy    []string
+	search     string
+	pageSize   int
+	err        error
+}
+
+// NewFilter returns a filter matching all conditions.
+func NewFilter(conditions ...Condition) *Filter {
+	return (&Filter{}).Where(conditions...)
+}
+
+// Where adds conditions to the filter. A condition without field makes the
+// filter invalid, see Err.
+func (f *Filter) Where(conditions ...Condition) *Filter {
+	for _, c := range conditions {
+		if c.Field == "" {
+			if f.err == nil {
+				f.err = fmt.Errorf("filter condition %q: field is mandatory", c.Lookup)
+			}
+			continue
+		}
+		f.conditions = append(f.conditions, c)
+	}
+	return f
+}
+
+// Err returns the error of the first invalid condition added to the filter.
+func (f *Filter) Err() error {
+	return f.err
+}
+
+// OrderBy sets the fields to order by, prefixed with "-" for descending order.
+func (f *Filter) OrderBy(fields ...string) *Filter {
+	f.orderBy = fields
+	return f
+}
+
+// Search sets the term to search in the fields AWX searches by default,
+// e.g. name and description.
+func (f *Filter) Search(term string) *Filter {
+	f.search = term
+	return f
+}
+
+// PageSize sets the number of results per page.
+func (f *Filter) PageSize(n int) *Filter {
+	f.pageSize = n
+	return f
+}
+
+// EncodeQuery implements the queryEncoder interface. It fails if the filter
+// is invalid.
+func (f *Filter) EncodeQuery(values url.Values) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.encode(values)
+	return nil
+}
+
+// encode adds the valid conditions, ordering, search and page size of the
+// filter to values.
+func (f *Filter) encode(values url.Values) {
+	for _, c := range f.conditions {
+		values.Add(c.Key(), c.Value)
+	}
+	if len(f.orderBy) > 0 {
+		values.Set("order_by", strings.Join(f.orderBy, ","))
+	}
+	if f.search != "" {
+		values.Set("search", f.search)
+	}
+	if f.pageSize > 0 {
+		values.Set("page_size", strconv.Itoa(f.pageSize))
+	}
+}
+
+// String returns the encoded query of the valid conditions of the filter.
+// Pass the filter itself as Filter of the list inputs, so that an invalid
+// filter fails the list call instead of matching everything.
+func (f *Filter) String() string {
+	values := url.Values{}
+	f.encode(values)
+	return values.Encode()
+}
+
+// ListAll retrieves all objects of the list at key matching opts, e.g. a
+// *Filter, following the pages of the result.
+func ListAll[T any](ctx context.Context, c Client, key ObjectKey, opts ListOption) ([]*T, error) {
+	return listAll[T](ctx, c, key, opts)
+}
+
+// encodeWithQuery encodes the schema tagged fields of input and adds the
+// values of filter and of the raw query.
+func encodeWithQuery(input any, filter *Filter, query string, values url.Values) error {
+	if err := encode.Encode(input, values); err != nil {
+		return err
+	}
+	if filter != nil {
+		if err := filter.EncodeQuery(values); err != nil {
+			return err
+		}
+	}
+	q, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	for key, vs := range q {
+		for _, v := range vs {
+			values.Add(key, v)
+		}
+	}
+	return nil
+}
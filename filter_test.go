@@ -0,0 +1,110 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterEncodeQuery(t *testing.T) {
+	f := NewFilter(
+		Related("job_template", "name").IContains("deploy"),
+		Field("status").In("failed", "error"),
+		Field("finished").GT(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Field("execution_node").IsNull(false),
+		Not(Field("launch_type").Eq("scheduled")),
+		Or(Field("limit").StartsWith("web")),
+		Or(Not(Field("elapsed").LTE(1.5))),
+	).Where(Field("id").Eq(3)).OrderBy("-finished", "id").Search("nightly").PageSize(25)
+
+	values := url.Values{}
+	assert.NoError(t, f.EncodeQuery(values))
+	assert.Equal(t, url.Values{
+		"job_template__name__icontains": {"deploy"},
+		"status__in":                    {"failed,error"},
+		"finished__gt":                  {"2025-01-02T03:04:05Z"},
+		"execution_node__isnull":        {"false"},
+		"not__launch_type":              {"scheduled"},
+		"or__limit__startswith":         {"web"},
+		"or__not__elapsed__lte":         {"1.5"},
+		"id":                            {"3"},
+		"order_by":                      {"-finished,id"},
+		"search":                        {"nightly"},
+		"page_size":                     {"25"},
+	}, values)
+
+	invalid := NewFilter(Field("id").Eq(3), Condition{Lookup: LookupIn})
+	assert.ErrorContains(t, invalid.Err(), "field is mandatory")
+	assert.Error(t, invalid.EncodeQuery(url.Values{}))
+	assert.Equal(t, "id=3", invalid.String())
+}
+
+func TestFilterWithListCalls(t *testing.T) {
+	var queries []url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{resource}/", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": [{"id": 1, "name": "deploy"}]}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	f := NewFilter(Field("name").IStartsWith("dep")).OrderBy("name").PageSize(50)
+	templates, err := ListAll[JobTemplate](context.Background(), client, ObjectKey{Resource: "job_templates"}, f)
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy", templates[0].Name)
+
+	err = client.List(context.Background(), ObjectKey{Resource: "jobs"}, &JobList{}, ListJobsInput{
+		LaunchType: "manual",
+		Filter:     NewFilter(Field("status").In("failed", "error")),
+	}, nil)
+	assert.NoError(t, err)
+
+	// An invalid filter fails the call instead of listing everything.
+	err = client.List(context.Background(), ObjectKey{Resource: "jobs"}, &JobList{}, ListJobsInput{
+		Filter: NewFilter(Condition{Value: "failed"}),
+	}, nil)
+	assert.ErrorContains(t, err, "field is mandatory")
+
+	err = client.List(context.Background(), ObjectKey{Resource: "inventories"}, &InventoryList{}, &InventoryListInput{
+		Name:  "prod",
+		Query: "?kind__in=smart,constructed",
+	}, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []url.Values{
+		{"name__istartswith": {"dep"}, "order_by": {"name"}, "page": {"1"}, "page_size": {"50"}},
+		{"launch_type": {"manual"}, "status__in": {"failed,error"}},
+		{"name": {"prod"}, "kind__in": {"smart,constructed"}},
+	}, queries)
+}
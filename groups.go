@@ -0,0 +1,307 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// GroupList represents the output of the ListGroups method.
+type GroupList struct {
+	ListGetResponse
+	Results []*Group `json:"results,omitempty"`
+}
+
+// Group represents the output of the GetGroups method.
+type Group struct {
+	ID          int       `json:"id,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	URL         string    `json:"url,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	Modified    string    `json:"modified,omitempty"`
+	Created     string    `json:"created,omitempty"`
+	Inventory   int       `json:"inventory"`
+	Variables   Variables `json:"variables"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListGroupsInput represents the input of the ListGroups method.
+type ListGroupsInput struct {
+	ID   string `schema:"id,omitempty"`
+	Name string `schema:"name,omitempty"`
+}
+
+const groupsResource = "groups"
+
+func groupSubKey(groupID int, action string) ObjectKey {
+	return ObjectKey{Resource: groupsResource, ResourceID: strconv.Itoa(groupID), Action: action}
+}
+
+// GetGroup retrieves the group with the given id.
+func GetGroup(ctx context.Context, c Client, id int) (*Group, error) {
+	return getByID[Group](ctx, c, groupsResource, id)
+}
+
+// ListInventoryGroups retrieves all groups of the given inventory matching input.
+func ListInventoryGroups(ctx context.Context, c Client, inventoryID int, input *ListGroupsInput) ([]*Group, error) {
+	return listAll[Group](ctx, c, ObjectKey{
+		Resource:   inventoriesResource,
+		ResourceID: strconv.Itoa(inventoryID),
+		Action:     groupsResource,
+	}, input)
+}
+
+// CreateGroup creates group in the inventory given by group.Inventory and
+// updates it with the response of the server.
+func CreateGroup(ctx context.Context, c Client, group *Group) error {
+	return c.Create(ctx, ObjectKey{
+		Resource:   inventoriesResource,
+		ResourceID: strconv.Itoa(group.Inventory),
+		Action:     groupsResource,
+	}, group, nil)
+}
+
+// UpdateGroup updates group in AWX.
+func UpdateGroup(ctx context.Context, c Client, group *Group) error {
+	return c.Update(ctx, idKey(groupsResource, group.ID), group, nil)
+}
+
+// DeleteGroup deletes the group with the given id. Its hosts are kept.
+func DeleteGroup(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(groupsResource, id), nil)
+}
+
+// ListGroupHosts retrieves the hosts which are direct members of the group.
+func ListGroupHosts(ctx context.Context, c Client, groupID int) ([]*Host, error) {
+	return listAll[Host](ctx, c, groupSubKey(groupID, hostsResource), nil)
+}
+
+// AddGroupHost adds the host to the group.
+func AddGroupHost(ctx context.Context, c Client, groupID, hostID int) error {
+	return Associate(ctx, c, groupSubKey(groupID, hostsResource), hostID)
+}
+
+// RemoveGroupHost removes the host from the group without deleting it.
+func RemoveGroupHost(ctx context.Context, c Client, groupID, hostID int) error {
+	return Disassociate(ctx, c, groupSubKey(groupID, hostsResource), hostID)
+}
+
+// ListGroupChildren retrieves the direct child groups of the group.
+func ListGroupChildren(ctx context.Context, c Client, groupID int) ([]*Group, error) {
+	return listAll[Group](ctx, c, groupSubKey(groupID, "children"), nil)
+}
+
+// AddGroupChild makes the group childID a child of the group parentID.
+func AddGroupChild(ctx context.Context, c Client, parentID, childID int) error {
+	return Associate(ctx, c, groupSubKey(parentID, "children"), childID)
+}
+
+// RemoveGroupChild removes the group childID from the children of the group
+// parentID without deleting it.
+func RemoveGroupChild(ctx context.Context, c Client, parentID, childID int) error {
+	return Disassociate(ctx, c, groupSubKey(parentID, "children"), childID)
+}
+
+// GroupTree represents the desired state of a group, its hosts and its
+// children. A group may appear below several parents, its hosts, children
+// and variables are then merged.
+type GroupTree struct {
+	Name      string
+	Variables Variables
+	Hosts     []string
+	Children  []*GroupTree
+}
+
+// GroupReconcileReport represents the changes made by ReconcileInventoryGroups.
+type GroupReconcileReport struct {
+	CreatedGroups   []string
+	UpdatedGroups   []string
+	DeletedGroups   []string
+	CreatedHosts    []string
+	AddedHosts      []string // "group/host"
+	RemovedHosts    []string // "group/host"
+	AddedChildren   []string // "parent/child"
+	RemovedChildren []string // "parent/child"
+}
+
+// desiredGroup is a group of a flattened GroupTree.
+type desiredGroup struct {
+	variables Variables
+	hosts     []string
+	children  []string
+}
+
+func flattenGroupTrees(trees []*GroupTree, groups map[string]*desiredGroup) error {
+	for _, t := range trees {
+		if t.Name == "" {
+			return errors.New("group without name")
+		}
+		g := groups[t.Name]
+		if g == nil {
+			g = &desiredGroup{}
+			groups[t.Name] = g
+		}
+		if len(t.Variables) > 0 {
+			if g.variables != nil && !reflect.DeepEqual(g.variables, t.Variables) {
+				return fmt.Errorf("group %q: conflicting variables", t.Name)
+			}
+			g.variables = t.Variables
+		}
+		for _, h := range t.Hosts {
+			if !slices.Contains(g.hosts, h) {
+				g.hosts = append(g.hosts, h)
+			}
+		}
+		for _, child := range t.Children {
+			if !slices.Contains(g.children, child.Name) {
+				g.children = append(g.children, child.Name)
+			}
+		}
+		if err := flattenGroupTrees(t.Children, groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileInventoryGroups makes the groups of the given inventory match
+// trees. Missing groups and hosts are created, group variables are updated,
+// host and child memberships are added or removed and groups which do not
+// appear in trees are deleted. Hosts are never deleted.
+func ReconcileInventoryGroups(ctx context.Context, c Client, inventoryID int, trees []*GroupTree) (*GroupReconcileReport, error) {
+	desired := map[string]*desiredGroup{}
+	if err := flattenGroupTrees(trees, desired); err != nil {
+		return nil, err
+	}
+	report := &GroupReconcileReport{}
+
+	groups, err := ListInventoryGroups(ctx, c, inventoryID, nil)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs := map[string]int{}
+	for _, g := range groups {
+		d := desired[g.Name]
+		if d == nil {
+			if err = DeleteGroup(ctx, c, g.ID); err != nil {
+				return report, fmt.Errorf("group %q: %w", g.Name, err)
+			}
+			report.DeletedGroups = append(report.DeletedGroups, g.Name)
+			continue
+		}
+		groupIDs[g.Name] = g.ID
+		if len(g.Variables) == 0 && len(d.variables) == 0 || reflect.DeepEqual(g.Variables, d.variables) {
+			continue
+		}
+		g.Variables = d.variables
+		if err = UpdateGroup(ctx, c, g); err != nil {
+			return report, fmt.Errorf("group %q: %w", g.Name, err)
+		}
+		report.UpdatedGroups = append(report.UpdatedGroups, g.Name)
+	}
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		if _, ok := groupIDs[name]; ok {
+			continue
+		}
+		g := &Group{Name: name, Inventory: inventoryID, Variables: desired[name].variables}
+		if err = CreateGroup(ctx, c, g); err != nil {
+			return report, fmt.Errorf("group %q: %w", name, err)
+		}
+		groupIDs[name] = g.ID
+		report.CreatedGroups = append(report.CreatedGroups, name)
+	}
+
+	hosts, err := ListInventoryHosts(ctx, c, inventoryID, nil)
+	if err != nil {
+		return report, err
+	}
+	hostIDs := map[string]int{}
+	for _, h := range hosts {
+		hostIDs[h.Name] = h.ID
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		d, groupID := desired[name], groupIDs[name]
+
+		current, err := ListGroupHosts(ctx, c, groupID)
+		if err != nil {
+			return report, fmt.Errorf("group %q: %w", name, err)
+		}
+		var currentHosts []string
+		for _, h := range current {
+			currentHosts = append(currentHosts, h.Name)
+			if !slices.Contains(d.hosts, h.Name) {
+				if err = RemoveGroupHost(ctx, c, groupID, h.ID); err != nil {
+					return report, fmt.Errorf("group %q: %w", name, err)
+				}
+				report.RemovedHosts = append(report.RemovedHosts, name+"/"+h.Name)
+			}
+		}
+		for _, host := range d.hosts {
+			if slices.Contains(currentHosts, host) {
+				continue
+			}
+			if _, ok := hostIDs[host]; !ok {
+				h := &Host{Name: host, Inventory: inventoryID, Enabled: true}
+				if err = CreateHost(ctx, c, h); err != nil {
+					return report, fmt.Errorf("host %q: %w", host, err)
+				}
+				hostIDs[host] = h.ID
+				report.CreatedHosts = append(report.CreatedHosts, host)
+			}
+			if err = AddGroupHost(ctx, c, groupID, hostIDs[host]); err != nil {
+				return report, fmt.Errorf("group %q: %w", name, err)
+			}
+			report.AddedHosts = append(report.AddedHosts, name+"/"+host)
+		}
+
+		children, err := ListGroupChildren(ctx, c, groupID)
+		if err != nil {
+			return report, fmt.Errorf("group %q: %w", name, err)
+		}
+		var currentChildren []string
+		for _, child := range children {
+			currentChildren = append(currentChildren, child.Name)
+			if !slices.Contains(d.children, child.Name) {
+				if err = RemoveGroupChild(ctx, c, groupID, child.ID); err != nil {
+					return report, fmt.Errorf("group %q: %w", name, err)
+				}
+				report.RemovedChildren = append(report.RemovedChildren, name+"/"+child.Name)
+			}
+		}
+		for _, child := range d.children {
+			if slices.Contains(currentChildren, child) {
+				continue
+			}
+			if err = AddGroupChild(ctx, c, groupID, groupIDs[child]); err != nil {
+				return report, fmt.Errorf("group %q: %w", name, err)
+			}
+			report.AddedChildren = append(report.AddedChildren, name+"/"+child)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,103 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileInventoryGroups(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /inventories/3/groups/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"results": [
+			{"id": 1, "name": "web", "inventory": 3, "variables": ""},
+			{"id": 2, "name": "old", "inventory": 3, "variables": ""}
+		]}`)
+	})
+	mux.HandleFunc("POST /inventories/3/groups/", func(w http.ResponseWriter, r *http.Request) {
+		var received Group
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, "prod", received.Name)
+		writeJSON(w, http.StatusCreated, `{"id": 5, "name": "prod", "inventory": 3}`)
+	})
+	mux.HandleFunc("DELETE /groups/2/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /inventories/3/hosts/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"results": [{"id": 10, "name": "web01", "inventory": 3}]}`)
+	})
+	mux.HandleFunc("POST /inventories/3/hosts/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusCreated, `{"id": 11, "name": "web02", "inventory": 3}`)
+	})
+	mux.HandleFunc("GET /groups/{id}/hosts/", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") == "1" {
+			writeJSON(w, http.StatusOK, `{"results": [{"id": 10, "name": "web01", "inventory": 3}]}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"results": []}`)
+	})
+	mux.HandleFunc("GET /groups/{id}/children/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"results": []}`)
+	})
+	var associated []string
+	mux.HandleFunc("POST /groups/{id}/{list}/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		associated = append(associated, r.PathValue("id")+"/"+r.PathValue("list"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	report, err := ReconcileInventoryGroups(context.Background(), client, 3, []*GroupTree{
+		{
+			Name: "prod",
+			Children: []*GroupTree{
+				{Name: "web", Hosts: []string{"web01", "web02"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"prod"}, report.CreatedGroups)
+	assert.Equal(t, []string{"old"}, report.DeletedGroups)
+	assert.Equal(t, []string{"web02"}, report.CreatedHosts)
+	assert.Equal(t, []string{"web/web02"}, report.AddedHosts)
+	assert.Equal(t, []string{"prod/web"}, report.AddedChildren)
+	assert.Empty(t, report.RemovedHosts)
+	assert.Equal(t, []string{"5/children", "1/hosts"}, associated)
+}
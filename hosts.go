@@ -0,0 +1,238 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"maps"
+	"strconv"
+	"time"
+)
+
+// HostList represents the output of the ListHosts method.
+type HostList struct {
+	ListGetResponse
+	Results []*Host `json:"results,omitempty"`
+}
+
+// Host represents the output of the GetHosts method.
+type Host struct {
+	ID                   int                `json:"id,omitempty"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	URL                  string             `json:"url,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Modified             string             `json:"modified,omitempty"`
+	Created              string             `json:"created,omitempty"`
+	Inventory            int                `json:"inventory"`
+	Enabled              bool               `json:"enabled"`
+	InstanceID           string             `json:"instance_id"`
+	Variables            Variables          `json:"variables"`
+	HasActiveFailures    bool               `json:"has_active_failures,omitempty"`
+	HasInventorySources  bool               `json:"has_inventory_sources,omitempty"`
+	LastJob              *int               `json:"last_job,omitempty"`
+	LastJobHostSummary   *int               `json:"last_job_host_summary,omitempty"`
+	AnsibleFactsModified *time.Time         `json:"ansible_facts_modified,omitempty"`
+	SummaryFields        *HostSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// HostSummaryFields represents the summary fields of a host.
+type HostSummaryFields struct {
+	RecentJobs       []*HostRecentJob `json:"recent_jobs,omitempty"`
+	UserCapabilities UserCapabilities `json:"user_capabilities"`
+}
+
+// HostRecentJob represents one of the recent jobs which ran against a host.
+type HostRecentJob struct {
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	Type     string    `json:"type"`
+	Status   string    `json:"status"`
+	Finished time.Time `json:"finished"`
+}
+
+// ListHostsInput represents the input of the ListHosts method.
+type ListHostsInput struct {
+	ID         string `schema:"id,omitempty"`
+	Name       string `schema:"name,omitempty"`
+	InstanceID string `schema:"instance_id,omitempty"`
+	Enabled    *bool  `schema:"enabled,omitempty"`
+}
+
+// JobHostSummaryList represents the output of the ListJobHostSummaries method.
+type JobHostSummaryList struct {
+	ListGetResponse
+	Results []*JobHostSummary `json:"results,omitempty"`
+}
+
+// JobHostSummary represents the outcome of a job on a single host.
+type JobHostSummary struct {
+	ID            int                         `json:"id"`
+	Job           int                         `json:"job"`
+	Host          int                         `json:"host"`
+	HostName      string                      `json:"host_name"`
+	Changed       int                         `json:"changed"`
+	Dark          int                         `json:"dark"`
+	Failures      int                         `json:"failures"`
+	Ok            int                         `json:"ok"`
+	Processed     int                         `json:"processed"`
+	Skipped       int                         `json:"skipped"`
+	Rescued       int                         `json:"rescued"`
+	Ignored       int                         `json:"ignored"`
+	Failed        bool                        `json:"failed"`
+	Created       string                      `json:"created"`
+	SummaryFields JobHostSummarySummaryFields `json:"summary_fields"`
+}
+
+// JobHostSummarySummaryFields represents the summary fields of a job host summary.
+type JobHostSummarySummaryFields struct {
+	Job *JobSummary `json:"job"`
+}
+
+const hostsResource = "hosts"
+
+// GetHost retrieves the host with the given id.
+func GetHost(ctx context.Context, c Client, id int) (*Host, error) {
+	return getByID[Host](ctx, c, hostsResource, id)
+}
+
+// ListHosts retrieves all hosts matching input.
+func ListHosts(ctx context.Context, c Client, input *ListHostsInput) ([]*Host, error) {
+	return listAll[Host](ctx, c, ObjectKey{Resource: hostsResource}, input)
+}
+
+// ListInventoryHosts retrieves all hosts of the given inventory matching input.
+func ListInventoryHosts(ctx context.Context, c Client, inventoryID int, input *ListHostsInput) ([]*Host, error) {
+	return listAll[Host](ctx, c, ObjectKey{
+		Resource:   inventoriesResource,
+		ResourceID: strconv.Itoa(inventoryID),
+		Action:     hostsResource,
+	}, input)
+}
+
+// CreateHost creates host in the inventory given by host.Inventory and
+// updates it with the response of the server.
+func CreateHost(ctx context.Context, c Client, host *Host) error {
+	return c.Create(ctx, ObjectKey{
+		Resource:   inventoriesResource,
+		ResourceID: strconv.Itoa(host.Inventory),
+		Action:     hostsResource,
+	}, host, nil)
+}
+
+// UpdateHost updates host in AWX.
+func UpdateHost(ctx context.Context, c Client, host *Host) error {
+	return c.Update(ctx, idKey(hostsResource, host.ID), host, nil)
+}
+
+// DeleteHost deletes the host with the given id.
+func DeleteHost(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(hostsResource, id), nil)
+}
+
+// EnableHost enables the host with the given id.
+func EnableHost(ctx context.Context, c Client, id int) error {
+	return setHostEnabled(ctx, c, id, true)
+}
+
+// DisableHost disables the host with the given id, so that jobs skip it.
+func DisableHost(ctx context.Context, c Client, id int) error {
+	return setHostEnabled(ctx, c, id, false)
+}
+
+func setHostEnabled(ctx context.Context, c Client, id int, enabled bool) error {
+	host, err := GetHost(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	if host.Enabled == enabled {
+		return nil
+	}
+	host.Enabled = enabled
+	return UpdateHost(ctx, c, host)
+}
+
+// SetHostVariables replaces the variables of the host with the given id.
+func SetHostVariables(ctx context.Context, c Client, id int, vars Variables) error {
+	host, err := GetHost(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	host.Variables = vars
+	return UpdateHost(ctx, c, host)
+}
+
+// MergeHostVariables merges vars into the variables of the host with the
+// given id, existing keys are overwritten and a nil value removes a key.
+func MergeHostVariables(ctx context.Context, c Client, id int, vars Variables) error {
+	host, err := GetHost(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	host.Variables = mergeVariables(host.Variables, vars)
+	return UpdateHost(ctx, c, host)
+}
+
+// mergeVariables returns a copy of base with vars merged into it. A nil value
+// in vars removes the key.
+func mergeVariables(base, vars Variables) Variables {
+	merged := maps.Clone(base)
+	if merged == nil {
+		merged = Variables{}
+	}
+	for k, v := range vars {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// GetHostFacts retrieves the ansible facts gathered for the host with the given id.
+func GetHostFacts(ctx context.Context, c Client, id int) (map[string]any, error) {
+	facts := map[string]any{}
+	err := c.Get(ctx, ObjectKey{Resource: hostsResource, ResourceID: strconv.Itoa(id), Action: "ansible_facts"}, &facts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return facts, nil
+}
+
+// ListHostJobSummaries retrieves the summaries of the most recent jobs which
+// ran against the host with the given id, newest first. At most limit
+// summaries are returned.
+func ListHostJobSummaries(ctx context.Context, c Client, id int, limit int) ([]*JobHostSummary, error) {
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+	list := JobHostSummaryList{}
+	err := c.List(ctx, ObjectKey{Resource: hostsResource, ResourceID: strconv.Itoa(id), Action: "job_host_summaries"},
+		&list, pageOptions{opts: recentOptions{OrderBy: "-id"}, page: 1, pageSize: limit}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return list.Results, nil
+}
+
+// recentOptions orders a list, e.g. newest first.
+type recentOptions struct {
+	OrderBy string `schema:"order_by,omitempty"`
+}
@@ -0,0 +1,80 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeHostVariables(t *testing.T) {
+	var updated map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /hosts/4/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{
+			"id": 4,
+			"name": "web01",
+			"inventory": 3,
+			"enabled": true,
+			"variables": "{\"ansible_host\": \"10.0.0.4\", \"role\": \"web\"}",
+			"summary_fields": {"recent_jobs": [{"id": 9, "name": "deploy", "type": "job", "status": "successful"}]}
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("PUT /hosts/4/", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&updated)
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /hosts/4/ansible_facts/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"ansible_distribution": "Ubuntu"}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	host, err := GetHost(context.Background(), client, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.4", host.Variables["ansible_host"])
+	assert.Equal(t, 9, host.SummaryFields.RecentJobs[0].ID)
+
+	err = MergeHostVariables(context.Background(), client, 4, Variables{"role": nil, "ntp": "10.0.0.1"})
+	assert.NoError(t, err)
+	vars, err := ParseVariables(updated["variables"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, Variables{"ansible_host": "10.0.0.4", "ntp": "10.0.0.1"}, vars)
+
+	facts, err := GetHostFacts(context.Background(), client, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ubuntu", facts["ansible_distribution"])
+}
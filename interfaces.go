@@ -58,6 +58,17 @@ type Reader interface {
 	List(ctx context.Context, key ObjectKey, list ObjectList, opts ListOption, httpStatus []int) error
 }
 
+// OptionsReader is optionally implemented by a Client which can describe
+// resources. It is not part of Reader so that existing implementations of
+// Client keep working.
+type OptionsReader interface {
+	// Options retrieves the description of the resource for the given object
+	// key, e.g. the fields and choices accepted when creating objects.
+	// obj must be a struct pointer so that obj can be updated with the response
+	// returned by the Server.
+	Options(ctx context.Context, key ObjectKey, obj Object, httpStatus []int) error
+}
+
 // Writer knows how to create, delete, and update Kubernetes objects.
 type Writer interface {
 	// Create saves the object obj in AWX. obj must be a
@@ -71,3 +82,13 @@ type Writer interface {
 	// struct pointer so that obj can be updated with the content returned by the Server.
 	Update(ctx context.Context, key ObjectKey, obj Object, httpStatus []int) error
 }
+
+// Patcher is optionally implemented by a Client which supports partial
+// updates. It is not part of Writer so that existing implementations of
+// Client keep working.
+type Patcher interface {
+	// Patch partially updates the given obj in AWX, only the fields set in obj
+	// are changed. obj must be a struct pointer so that obj can be updated with
+	// the content returned by the Server.
+	Patch(ctx context.Context, key ObjectKey, obj Object, httpStatus []int) error
+}
@@ -20,6 +20,11 @@
 package awx
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
 	"github.com/gorilla/schema"
 )
 
@@ -31,21 +36,101 @@ type InventoryList struct {
 	Results []*Inventory `json:"results,omitempty"`
 }
 
+// InventoryKind represents the kind of an inventory.
+type InventoryKind string
+
+// Inventory kinds supported by AWX.
+const (
+	InventoryKindRegular     InventoryKind = ""
+	InventoryKindSmart       InventoryKind = "smart"
+	InventoryKindConstructed InventoryKind = "constructed"
+)
+
 // Inventory represents the output of the GetInventories method.
 type Inventory struct {
-	ID                int    `json:"id"`
-	Name              string `json:"name"`
-	URL               string `json:"url"`
-	Type              string `json:"type"`
-	Modified          string `json:"modified"`
-	Created           string `json:"created"`
-	HasActiveFailures bool   `json:"has_active_failures"`
-	TotalHosts        int    `json:"total_hosts"`
-	PendingDeletion   bool   `json:"pending_deletion"`
+	ID                           int           `json:"id,omitempty"`
+	Name                         string        `json:"name"`
+	Description                  string        `json:"description"`
+	URL                          string        `json:"url,omitempty"`
+	Type                         string        `json:"type,omitempty"`
+	Modified                     string        `json:"modified,omitempty"`
+	Created                      string        `json:"created,omitempty"`
+	Organization                 int           `json:"organization"`
+	Kind                         InventoryKind `json:"kind"`
+	HostFilter                   string        `json:"host_filter,omitempty"`
+	Variables                    Variables     `json:"variables"`
+	PreventInstanceGroupFallback bool          `json:"prevent_instance_group_fallback"`
+	HasActiveFailures            bool          `json:"has_active_failures,omitempty"`
+	TotalHosts                   int           `json:"total_hosts,omitempty"`
+	PendingDeletion              bool          `json:"pending_deletion,omitempty"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
 }
 
 // InventoryListInput represents the input of the ListInventories method.
 type InventoryListInput struct {
-	Query string `schema:"-,omitempty"`
+	// Filter holds additional filters. An invalid Filter fails the list call.
+	Filter *Filter `schema:"-"`
+	// Query holds additional filters as raw query.
+	Query string `schema:"-"`
 	Name  string `schema:"name,omitempty"`
 }
+
+// EncodeQuery implements the queryEncoder interface.
+func (in InventoryListInput) EncodeQuery(values url.Values) error {
+	return encodeWithQuery(in, in.Filter, in.Query, values)
+}
+
+const inventoriesResource = "inventories"
+
+// Validate checks the inventory before it is sent to AWX.
+func (i *Inventory) Validate() error {
+	switch i.Kind {
+	case InventoryKindRegular, InventoryKindConstructed:
+		if i.HostFilter != "" {
+			return fmt.Errorf("inventory %q: host_filter is only supported by smart inventories", i.Name)
+		}
+	case InventoryKindSmart:
+		if i.HostFilter == "" {
+			return fmt.Errorf("inventory %q: smart inventories need a host_filter", i.Name)
+		}
+	default:
+		return fmt.Errorf("inventory %q: unknown kind %q", i.Name, i.Kind)
+	}
+	return nil
+}
+
+// GetInventory retrieves the inventory with the given id.
+func GetInventory(ctx context.Context, c Client, id int) (*Inventory, error) {
+	return getByID[Inventory](ctx, c, inventoriesResource, id)
+}
+
+// GetInventoryByName retrieves the inventory with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several inventories match.
+func GetInventoryByName(ctx context.Context, c Client, name, organization string) (*Inventory, error) {
+	return GetByName[Inventory](ctx, c, inventoriesResource, name, organization)
+}
+
+// CreateInventory creates inv in AWX and updates it with the response of the server.
+func CreateInventory(ctx context.Context, c Client, inv *Inventory) error {
+	if err := inv.Validate(); err != nil {
+		return err
+	}
+	return c.Create(ctx, ObjectKey{Resource: inventoriesResource}, inv, nil)
+}
+
+// UpdateInventory updates inv in AWX. The kind of an inventory cannot be
+// changed after its creation.
+func UpdateInventory(ctx context.Context, c Client, inv *Inventory) error {
+	if err := inv.Validate(); err != nil {
+		return err
+	}
+	return c.Update(ctx, idKey(inventoriesResource, inv.ID), inv, nil)
+}
+
+// DeleteInventory deletes the inventory with the given id. AWX deletes
+// inventories asynchronously, they are marked with pending_deletion until then.
+func DeleteInventory(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(inventoriesResource, id), []int{http.StatusAccepted, http.StatusNoContent})
+}
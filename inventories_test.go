@@ -0,0 +1,79 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateInventory(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /inventories/", func(w http.ResponseWriter, r *http.Request) {
+		received := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, "smart", received["kind"])
+		assert.Equal(t, `{"region":"eu-de-1"}`, received["variables"])
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{
+			"id": 3,
+			"name": "web",
+			"organization": 1,
+			"kind": "smart",
+			"host_filter": "name__startswith=web",
+			"variables": "---\nregion: eu-de-1\n"
+		}`))
+		assert.NoError(t, err)
+	})
+	// AWX redirects the collection path without a trailing slash, which
+	// would turn the POST into a GET.
+	mux.HandleFunc("/inventories", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		http.Redirect(w, r, "/inventories/", http.StatusMovedPermanently)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	inv := Inventory{
+		Name:         "web",
+		Organization: 1,
+		Kind:         InventoryKindSmart,
+		Variables:    Variables{"region": "eu-de-1"},
+	}
+	err = CreateInventory(context.Background(), client, &inv)
+	assert.ErrorContains(t, err, "smart inventories need a host_filter")
+
+	inv.HostFilter = "name__startswith=web"
+	err = CreateInventory(context.Background(), client, &inv)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, inv.ID)
+	assert.Equal(t, Variables{"region": "eu-de-1"}, inv.Variables)
+}
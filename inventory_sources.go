@@ -0,0 +1,187 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Inventory source types supported by AWX.
+const (
+	InventorySourceFile        = "file"
+	InventorySourceSCM         = "scm"
+	InventorySourceConstructed = "constructed"
+	InventorySourceEC2         = "ec2"
+	InventorySourceGCE         = "gce"
+	InventorySourceAzureRM     = "azure_rm"
+	InventorySourceVMware      = "vmware"
+	InventorySourceSatellite6  = "satellite6"
+	InventorySourceOpenStack   = "openstack"
+	InventorySourceRHV         = "rhv"
+	InventorySourceController  = "controller"
+	InventorySourceInsights    = "insights"
+)
+
+// InventorySourceList represents the output of the ListInventorySources method.
+type InventorySourceList struct {
+	ListGetResponse
+	Results []*InventorySource `json:"results,omitempty"`
+}
+
+// InventorySource represents the output of the GetInventorySources method.
+type InventorySource struct {
+	ID                   int       `json:"id,omitempty"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	URL                  string    `json:"url,omitempty"`
+	Type                 string    `json:"type,omitempty"`
+	Modified             string    `json:"modified,omitempty"`
+	Created              string    `json:"created,omitempty"`
+	Inventory            int       `json:"inventory"`
+	Source               string    `json:"source"`
+	SourcePath           string    `json:"source_path,omitempty"`
+	SourceProject        *int      `json:"source_project,omitempty"`
+	SourceVars           Variables `json:"source_vars"`
+	Credential           *int      `json:"credential"`
+	ExecutionEnvironment *int      `json:"execution_environment,omitempty"`
+	EnabledVar           string    `json:"enabled_var"`
+	EnabledValue         string    `json:"enabled_value"`
+	HostFilter           string    `json:"host_filter"`
+	Overwrite            bool      `json:"overwrite"`
+	OverwriteVars        bool      `json:"overwrite_vars"`
+	UpdateOnLaunch       bool      `json:"update_on_launch"`
+	UpdateCacheTimeout   int       `json:"update_cache_timeout"`
+	Timeout              int       `json:"timeout"`
+	Verbosity            int       `json:"verbosity"`
+	Status               string    `json:"status,omitempty"`
+	LastUpdated          *string   `json:"last_updated,omitempty"`
+	LastUpdateFailed     bool      `json:"last_update_failed,omitempty"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// InventoryUpdateList represents the output of the ListInventoryUpdates method.
+type InventoryUpdateList struct {
+	ListGetResponse
+	Results []*InventoryUpdate `json:"results,omitempty"`
+}
+
+// InventoryUpdate represents the sync job of an inventory source.
+type InventoryUpdate struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	URL                string    `json:"url"`
+	Type               string    `json:"type"`
+	Modified           string    `json:"modified"`
+	Created            string    `json:"created"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	InventorySource    int       `json:"inventory_source"`
+	Inventory          int       `json:"inventory"`
+	Source             string    `json:"source"`
+	LaunchType         string    `json:"launch_type"`
+	Status             string    `json:"status"`
+	Failed             bool      `json:"failed"`
+	Started            time.Time `json:"started"`
+	Finished           time.Time `json:"finished"`
+	Elapsed            float64   `json:"elapsed"`
+	JobExplanation     string    `json:"job_explanation"`
+	LicenseError       bool      `json:"license_error"`
+	OrgHostLimitError  bool      `json:"org_host_limit_error"`
+}
+
+// SyncInventorySourceOutput represents the output of the SyncInventorySource method.
+type SyncInventorySourceOutput struct {
+	ID              int    `json:"id"`
+	InventoryUpdate int    `json:"inventory_update"`
+	Status          string `json:"status"`
+}
+
+// ListInventorySourcesInput represents the input of the ListInventorySources method.
+type ListInventorySourcesInput struct {
+	ID        string `schema:"id,omitempty"`
+	Name      string `schema:"name,omitempty"`
+	Source    string `schema:"source,omitempty"`
+	Inventory int    `schema:"inventory,omitempty"`
+}
+
+const (
+	inventorySourcesResource = "inventory_sources"
+	inventoryUpdatesResource = "inventory_updates"
+)
+
+// GetInventorySource retrieves the inventory source with the given id.
+func GetInventorySource(ctx context.Context, c Client, id int) (*InventorySource, error) {
+	return getByID[InventorySource](ctx, c, inventorySourcesResource, id)
+}
+
+// ListInventorySources retrieves all inventory sources matching input.
+func ListInventorySources(ctx context.Context, c Client, input *ListInventorySourcesInput) ([]*InventorySource, error) {
+	return listAll[InventorySource](ctx, c, ObjectKey{Resource: inventorySourcesResource}, input)
+}
+
+// CreateInventorySource creates src in AWX and updates it with the response of the server.
+func CreateInventorySource(ctx context.Context, c Client, src *InventorySource) error {
+	return c.Create(ctx, ObjectKey{Resource: inventorySourcesResource}, src, nil)
+}
+
+// UpdateInventorySource updates src in AWX.
+func UpdateInventorySource(ctx context.Context, c Client, src *InventorySource) error {
+	return c.Update(ctx, idKey(inventorySourcesResource, src.ID), src, nil)
+}
+
+// DeleteInventorySource deletes the inventory source with the given id.
+func DeleteInventorySource(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(inventorySourcesResource, id), nil)
+}
+
+// SyncInventorySource starts a sync of the inventory source with the given id
+// through its update action and returns the id of the resulting inventory update.
+func SyncInventorySource(ctx context.Context, c Client, id int) (int, error) {
+	output := SyncInventorySourceOutput{}
+	key := ObjectKey{Resource: inventorySourcesResource, ResourceID: strconv.Itoa(id), Action: "update"}
+	if err := post(ctx, c, key, &struct{}{}, &output, []int{http.StatusAccepted, http.StatusCreated}); err != nil {
+		return 0, err
+	}
+	return output.InventoryUpdate, nil
+}
+
+// GetInventoryUpdate retrieves the inventory update with the given id.
+func GetInventoryUpdate(ctx context.Context, c Client, id int) (*InventoryUpdate, error) {
+	return getByID[InventoryUpdate](ctx, c, inventoryUpdatesResource, id)
+}
+
+// WaitInventoryUpdate polls the inventory update with the given id every
+// interval until it finished or ctx is done.
+func WaitInventoryUpdate(ctx context.Context, c Client, id int, interval time.Duration) (*InventoryUpdate, error) {
+	return waitFinished(ctx, interval, func() (*InventoryUpdate, string, error) {
+		update, err := GetInventoryUpdate(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return update, update.Status, nil
+	})
+}
+
+// GetInventoryUpdateStdout retrieves the output of the inventory update with the given id.
+func GetInventoryUpdateStdout(ctx context.Context, c Client, id int) (string, error) {
+	return getStdout(ctx, c, inventoryUpdatesResource, id)
+}
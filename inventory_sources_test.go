@@ -0,0 +1,75 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncInventorySource(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /inventory_sources/2/update/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusAccepted, `{"id": 8, "inventory_update": 8, "status": "pending"}`)
+	})
+	mux.HandleFunc("GET /inventory_updates/8/", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "running"
+		if polls > 1 {
+			status = "successful"
+		}
+		writeJSON(w, http.StatusOK, `{"id": 8, "inventory_source": 2, "source": "openstack", "status": "`+status+`"}`)
+	})
+	mux.HandleFunc("GET /inventory_updates/8/stdout/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		writeJSON(w, http.StatusOK, `{"range": {"start": 0, "end": 1}, "content": "Processing JSON output...\n"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	updateID, err := SyncInventorySource(context.Background(), client, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, updateID)
+
+	update, err := WaitInventoryUpdate(context.Background(), client, updateID, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, JobStatusSuccessful, update.Status)
+	assert.Equal(t, InventorySourceOpenStack, update.Source)
+
+	out, err := GetInventoryUpdateStdout(context.Background(), client, updateID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Processing JSON output...\n", out)
+}
@@ -19,6 +19,11 @@
 
 package awx
 
+import (
+	"context"
+	"net/url"
+)
+
 // JobTemplateList represents the output of the ListJobTemplates method.
 type JobTemplateList struct {
 	ListGetResponse
@@ -34,21 +39,41 @@ type JobTemplate struct {
 	Modified string `json:"modified"`
 	Created  string `json:"created"`
 	Status   string `json:"status"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
 }
 
 // ListJobTemplateInput represents the input of the ListJobTemplates method.
 type ListJobTemplateInput struct {
-	ID    string `schema:"id,omitempty"`
-	Name  string `schema:"name,omitempty"`
-	Query string `schema:"-,omitempty"`
+	ID        string `schema:"id,omitempty"`
+	Name      string `schema:"name,omitempty"`
+	Inventory int    `schema:"inventory,omitempty"`
+	// Filter holds additional filters. An invalid Filter fails the list call.
+	Filter *Filter `schema:"-"`
+	// Query holds additional filters as raw query.
+	Query string `schema:"-"`
+}
+
+// EncodeQuery implements the queryEncoder interface.
+func (in ListJobTemplateInput) EncodeQuery(values url.Values) error {
+	return encodeWithQuery(in, in.Filter, in.Query, values)
 }
 
 // CreateJobTemplatesSchedule represents the input of the PostJobTemplateSchedule method.
+//
+// Deprecated: use ScheduleInput with CreateJobTemplateSchedule.
 type CreateJobTemplatesSchedule struct {
-	Name               string `json:"name"`
-	RRULE              string `json:"rrule"`
-	UnifiedJobTemplate int    `json:"unified_job_template"`
-	ExtraData          string `json:"extra_data"`
-	Inventory          string `json:"inventory"`
-	Limit              string `json:"limit"`
+	Name               string    `json:"name"`
+	RRULE              string    `json:"rrule"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	ExtraData          ExtraData `json:"extra_data,omitempty"`
+	Inventory          int       `json:"inventory,omitempty"`
+	Limit              string    `json:"limit"`
+}
+
+// GetJobTemplateByName retrieves the job template with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several job templates match.
+func GetJobTemplateByName(ctx context.Context, c Client, name, organization string) (*JobTemplate, error) {
+	return GetByName[JobTemplate](ctx, c, "job_templates", name, organization)
 }
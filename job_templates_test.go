@@ -29,7 +29,7 @@ import (
 
 func TestJobTemplates(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET /job_templates", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("GET /job_templates/", func(w http.ResponseWriter, r *http.Request) {
 		auth := r.Header.Get("Authorization")
 		if auth != "Bearer 12345" {
 			http.Error(w, "Auth header was incorrect", http.StatusUnauthorized)
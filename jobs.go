@@ -18,7 +18,13 @@
 
 package awx
 
-import "time"
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
 
 // JobList represents the output of the ListJobs method.
 type JobList struct {
@@ -39,6 +45,8 @@ type Job struct {
 	Failed             bool      `json:"failed"`
 	Started            time.Time `json:"started"`
 	Finished           time.Time `json:"finished"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
 }
 
 // ListJobsInput represents the input of the ListJobs method.
@@ -46,10 +54,105 @@ type ListJobsInput struct {
 	ID         string `schema:"id,omitempty"`
 	LaunchType string `schema:"launch_type,omitempty"`
 	ScheduleID int    `schema:"schedule__id,omitempty"`
-	Query      string `schema:"query,omitempty"`
+	// Filter holds additional filters. An invalid Filter fails the list call.
+	Filter *Filter `schema:"-"`
+	// Query holds additional filters as raw query.
+	Query string `schema:"-"`
+}
+
+// EncodeQuery implements the queryEncoder interface.
+func (in ListJobsInput) EncodeQuery(values url.Values) error {
+	return encodeWithQuery(in, in.Filter, in.Query, values)
 }
 
 // CanCancelJob represents the output of the GetCancelJob method.
 type CanCancelJob struct {
 	CanCancel bool `json:"can_cancel"`
 }
+
+// Job statuses reported by AWX for all kinds of unified jobs.
+const (
+	JobStatusNew        = "new"
+	JobStatusPending    = "pending"
+	JobStatusWaiting    = "waiting"
+	JobStatusRunning    = "running"
+	JobStatusSuccessful = "successful"
+	JobStatusFailed     = "failed"
+	JobStatusError      = "error"
+	JobStatusCanceled   = "canceled"
+)
+
+// IsFinishedJobStatus reports whether status is a final status.
+func IsFinishedJobStatus(status string) bool {
+	return slices.Contains([]string{JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled}, status)
+}
+
+// DefaultWaitInterval is the poll interval used by the wait helpers when
+// none is given.
+const DefaultWaitInterval = 5 * time.Second
+
+// GetJob retrieves the job with the given id.
+func GetJob(ctx context.Context, c Client, id int) (*Job, error) {
+	return getByID[Job](ctx, c, "jobs", id)
+}
+
+// WaitJob polls the job with the given id every interval until it finished
+// or ctx is done.
+func WaitJob(ctx context.Context, c Client, id int, interval time.Duration) (*Job, error) {
+	return waitFinished(ctx, interval, func() (*Job, string, error) {
+		job, err := GetJob(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return job, job.Status, nil
+	})
+}
+
+// waitFinished calls poll every interval until the status it returns is
+// final or ctx is done.
+func waitFinished[T any](ctx context.Context, interval time.Duration, poll func() (*T, string, error)) (*T, error) {
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		obj, status, err := poll()
+		if err != nil {
+			return nil, err
+		}
+		if IsFinishedJobStatus(status) {
+			return obj, nil
+		}
+		select {
+		case <-ctx.Done():
+			return obj, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// stdoutOptions requests the stdout of a unified job wrapped in JSON.
+type stdoutOptions struct {
+	Format string `schema:"format"`
+}
+
+// stdout represents the stdout of a unified job in JSON format.
+type stdout struct {
+	Content string `json:"content"`
+}
+
+// getStdout retrieves the stdout of the unified job with the given id.
+func getStdout(ctx context.Context, c Client, resource string, id int) (string, error) {
+	out := stdout{}
+	key := ObjectKey{Resource: resource, ResourceID: strconv.Itoa(id), Action: "stdout"}
+	if err := c.List(ctx, key, &out, stdoutOptions{Format: "json"}, nil); err != nil {
+		return "", err
+	}
+	return out.Content, nil
+}
+
+// GetJobStdout retrieves the stdout of the job with the given id.
+func GetJobStdout(ctx context.Context, c Client, id int) (string, error) {
+	return getStdout(ctx, c, "jobs", id)
+}
@@ -22,14 +22,16 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestListJobs(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET /jobs", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("GET /jobs/", func(w http.ResponseWriter, r *http.Request) {
 		auth := r.Header.Get("Authorization")
 		if auth != "Bearer 12345" {
 			http.Error(w, "Auth header was incorrect", http.StatusUnauthorized)
@@ -78,7 +80,7 @@ func TestListJobs(t *testing.T) {
 
 func TestGetJobs(t *testing.T) {
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET /jobs/1", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("GET /jobs/1/", func(w http.ResponseWriter, r *http.Request) {
 		auth := r.Header.Get("Authorization")
 		if auth != "Bearer 12345" {
 			http.Error(w, "Auth header was incorrect", http.StatusUnauthorized)
@@ -161,3 +163,34 @@ func TestCanCancelJob(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, true, result.CanCancel)
 }
+
+func TestWaitJob(t *testing.T) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /jobs/1/", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "running"
+		if polls == 3 {
+			status = "failed"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"id": 1, "status": "` + status + `", "failed": ` + strconv.FormatBool(polls == 3) + `}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(
+		ClientOptions{
+			Endpoint: server.URL + "/",
+			Token:    "12345",
+		},
+	)
+	assert.NoError(t, err)
+
+	job, err := WaitJob(context.Background(), client, 1, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, JobStatusFailed, job.Status)
+	assert.True(t, job.Failed)
+}
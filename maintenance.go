@@ -0,0 +1,186 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"slices"
+)
+
+// ScheduleState represents the enabled state of a schedule.
+type ScheduleState struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// MaintenanceWindow records the enabled state of the schedules paused by
+// PauseSchedules, so that RestoreSchedules can restore exactly that state.
+// It can be encoded as JSON to restore from another process.
+type MaintenanceWindow struct {
+	Schedules []ScheduleState `json:"schedules"`
+}
+
+// MaintenanceReport represents what PauseSchedules or RestoreSchedules did.
+type MaintenanceReport struct {
+	// Changed holds the schedules whose state was changed, with their new state.
+	Changed []ScheduleState
+	// Unchanged holds the schedules which already were in the wanted state.
+	Unchanged []ScheduleState
+	// Missing holds the ids of the schedules deleted since the pause.
+	Missing []int
+}
+
+// FindTemplateSchedules retrieves the schedules of the given unified job
+// templates, e.g. job templates, workflow job templates, projects or
+// inventory sources.
+func FindTemplateSchedules(ctx context.Context, c Client, templateIDs []int) ([]*Schedule, error) {
+	var schedules []*Schedule
+	for _, id := range templateIDs {
+		list, err := ListSchedules(ctx, c, &ListSchedulesInput{UnifiedJobTemplate: id})
+		if err != nil {
+			return nil, err
+		}
+		schedules = appendSchedules(schedules, list)
+	}
+	return schedules, nil
+}
+
+// FindInventorySchedules retrieves the schedules touching the inventory with
+// the given id: the schedules of its inventory sources and of the job and
+// workflow job templates using it, as well as the schedules overriding the
+// inventory of their template with it.
+func FindInventorySchedules(ctx context.Context, c Client, inventoryID int) ([]*Schedule, error) {
+	schedules, err := ListSchedules(ctx, c, &ListSchedulesInput{Inventory: inventoryID})
+	if err != nil {
+		return nil, err
+	}
+	var templateIDs []int
+	sources, err := ListInventorySources(ctx, c, &ListInventorySourcesInput{Inventory: inventoryID})
+	if err != nil {
+		return nil, err
+	}
+	for _, src := range sources {
+		templateIDs = append(templateIDs, src.ID)
+	}
+	templates, err := listAll[JobTemplate](ctx, c, ObjectKey{Resource: "job_templates"}, &ListJobTemplateInput{Inventory: inventoryID})
+	if err != nil {
+		return nil, err
+	}
+	for _, jt := range templates {
+		templateIDs = append(templateIDs, jt.ID)
+	}
+	workflows, err := listAll[WorkflowJobTemplate](ctx, c, ObjectKey{Resource: workflowJobTemplatesResource}, &ListWorkflowJobTemplatesInput{Inventory: inventoryID})
+	if err != nil {
+		return nil, err
+	}
+	for _, wjt := range workflows {
+		templateIDs = append(templateIDs, wjt.ID)
+	}
+	templateSchedules, err := FindTemplateSchedules(ctx, c, templateIDs)
+	if err != nil {
+		return nil, err
+	}
+	return appendSchedules(schedules, templateSchedules), nil
+}
+
+// appendSchedules appends the schedules not yet contained in list.
+func appendSchedules(list, schedules []*Schedule) []*Schedule {
+	for _, s := range schedules {
+		if !slices.ContainsFunc(list, func(o *Schedule) bool { return o.ID == s.ID }) {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// PauseSchedules disables the given schedules and records their previous
+// state in the returned window. On error, the window holds the schedules
+// paused so far, so that they can still be restored.
+func PauseSchedules(ctx context.Context, c Client, schedules []*Schedule) (*MaintenanceWindow, *MaintenanceReport, error) {
+	window := &MaintenanceWindow{}
+	report := &MaintenanceReport{}
+	for _, s := range schedules {
+		state := ScheduleState{ID: s.ID, Name: s.Name, Enabled: s.Enabled}
+		if !s.Enabled {
+			window.Schedules = append(window.Schedules, state)
+			report.Unchanged = append(report.Unchanged, state)
+			continue
+		}
+		if err := setScheduleEnabled(ctx, c, s.ID, nil, false); err != nil {
+			return window, report, err
+		}
+		window.Schedules = append(window.Schedules, state)
+		state.Enabled = false
+		report.Changed = append(report.Changed, state)
+	}
+	return window, report, nil
+}
+
+// RestoreSchedules sets the schedules of window back to the state recorded
+// by PauseSchedules. Schedules deleted in the meantime are reported as
+// missing.
+func RestoreSchedules(ctx context.Context, c Client, window *MaintenanceWindow) (*MaintenanceReport, error) {
+	report := &MaintenanceReport{}
+	for _, state := range window.Schedules {
+		current, err := GetSchedule(ctx, c, state.ID)
+		if isNotFound(err) {
+			report.Missing = append(report.Missing, state.ID)
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+		if current.Enabled == state.Enabled {
+			report.Unchanged = append(report.Unchanged, state)
+			continue
+		}
+		if err = setScheduleEnabled(ctx, c, state.ID, current, state.Enabled); err != nil {
+			return report, err
+		}
+		report.Changed = append(report.Changed, state)
+	}
+	return report, nil
+}
+
+// setScheduleEnabled enables or disables the schedule with the given id.
+// Clients that do not implement Patcher update the whole schedule instead,
+// starting from current or, if nil, from the schedule retrieved from AWX.
+func setScheduleEnabled(ctx context.Context, c Client, id int, current *Schedule, enabled bool) error {
+	if _, ok := c.(Patcher); ok {
+		_, err := PatchSchedule(ctx, c, id, &ScheduleInput{Enabled: &enabled})
+		return err
+	}
+	if current == nil {
+		var err error
+		if current, err = GetSchedule(ctx, c, id); err != nil {
+			return err
+		}
+	}
+	_, err := UpdateSchedule(ctx, c, id, &ScheduleInput{
+		Name:               current.Name,
+		Description:        current.Description,
+		RRULE:              current.RRULE,
+		UnifiedJobTemplate: current.UnifiedJobTemplate,
+		Enabled:            &enabled,
+		ExtraData:          current.ExtraData,
+		ScheduleOverrides:  current.ScheduleOverrides,
+	})
+	return err
+}
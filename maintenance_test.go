@@ -0,0 +1,181 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseAndRestoreInventorySchedules(t *testing.T) {
+	enabled := map[int]bool{1: true, 2: false, 3: true, 4: true}
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	schedule := func(id int) string {
+		return fmt.Sprintf(`{"id": %d, "name": "s%d", "enabled": %t}`, id, id, enabled[id])
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /schedules/", func(w http.ResponseWriter, r *http.Request) {
+		var ids []int
+		switch q := r.URL.Query(); {
+		case q.Get("inventory") == "5":
+			ids = []int{3}
+		case q.Get("unified_job_template") == "20":
+			ids = []int{1}
+		case q.Get("unified_job_template") == "30":
+			ids = []int{2, 3}
+		case q.Get("unified_job_template") == "40":
+			ids = []int{4}
+		}
+		body := `{"results": [`
+		for i, id := range ids {
+			if i > 0 {
+				body += ","
+			}
+			body += schedule(id)
+		}
+		writeJSON(w, http.StatusOK, body+"]}")
+	})
+	mux.HandleFunc("GET /inventory_sources/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5", r.URL.Query().Get("inventory"))
+		writeJSON(w, http.StatusOK, `{"results": [{"id": 20, "name": "openstack", "inventory": 5}]}`)
+	})
+	mux.HandleFunc("GET /job_templates/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5", r.URL.Query().Get("inventory"))
+		writeJSON(w, http.StatusOK, `{"results": [{"id": 30, "name": "deploy"}]}`)
+	})
+	mux.HandleFunc("GET /workflow_job_templates/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5", r.URL.Query().Get("inventory"))
+		writeJSON(w, http.StatusOK, `{"results": [{"id": 40, "name": "release"}]}`)
+	})
+	mux.HandleFunc("GET /schedules/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		if _, ok := enabled[id]; !ok {
+			writeJSON(w, http.StatusNotFound, `{"detail": "Not found."}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, schedule(id))
+	})
+	mux.HandleFunc("PATCH /schedules/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		var received ScheduleInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		enabled[id] = *received.Enabled
+		writeJSON(w, http.StatusOK, schedule(id))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	schedules, err := FindInventorySchedules(context.Background(), client, 5)
+	assert.NoError(t, err)
+	var ids []int
+	for _, s := range schedules {
+		ids = append(ids, s.ID)
+	}
+	assert.Equal(t, []int{3, 1, 2, 4}, ids)
+
+	window, report, err := PauseSchedules(context.Background(), client, schedules)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{1: false, 2: false, 3: false, 4: false}, enabled)
+	assert.ElementsMatch(t, []ScheduleState{{ID: 1, Name: "s1"}, {ID: 3, Name: "s3"}, {ID: 4, Name: "s4"}}, report.Changed)
+	assert.Equal(t, []ScheduleState{{ID: 2, Name: "s2"}}, report.Unchanged)
+
+	// The window survives a round trip through JSON, e.g. a state file.
+	data, err := json.Marshal(window)
+	assert.NoError(t, err)
+	window = &MaintenanceWindow{}
+	assert.NoError(t, json.Unmarshal(data, window))
+
+	delete(enabled, 3)
+	report, err = RestoreSchedules(context.Background(), client, window)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{1: true, 2: false, 4: true}, enabled)
+	assert.Equal(t, []ScheduleState{{ID: 1, Name: "s1", Enabled: true}, {ID: 4, Name: "s4", Enabled: true}}, report.Changed)
+	assert.Equal(t, []ScheduleState{{ID: 2, Name: "s2"}}, report.Unchanged)
+	assert.Equal(t, []int{3}, report.Missing)
+}
+
+func TestPauseAndRestoreSchedulesWithoutPatcher(t *testing.T) {
+	enabled := map[int]bool{1: true}
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	schedule := func(id int) string {
+		return fmt.Sprintf(`{"id": %d, "name": "s%d", "rrule": "DTSTART:20250101T000000Z RRULE:FREQ=DAILY;INTERVAL=1",
+			"unified_job_template": 30, "limit": "web", "enabled": %t}`, id, id, enabled[id])
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /schedules/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		writeJSON(w, http.StatusOK, schedule(id))
+	})
+	mux.HandleFunc("PUT /schedules/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		var received ScheduleInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, "s1", received.Name)
+		assert.Equal(t, "DTSTART:20250101T000000Z RRULE:FREQ=DAILY;INTERVAL=1", received.RRULE)
+		assert.Equal(t, 30, received.UnifiedJobTemplate)
+		if assert.NotNil(t, received.Limit) {
+			assert.Equal(t, "web", *received.Limit)
+		}
+		enabled[id] = *received.Enabled
+		writeJSON(w, http.StatusOK, schedule(id))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+	// Hide the Patcher implementation of client.
+	plain := struct{ Client }{client}
+
+	window, report, err := PauseSchedules(context.Background(), plain, []*Schedule{{ID: 1, Name: "s1", Enabled: true}})
+	assert.NoError(t, err)
+	assert.False(t, enabled[1])
+	assert.Equal(t, []ScheduleState{{ID: 1, Name: "s1"}}, report.Changed)
+
+	report, err = RestoreSchedules(context.Background(), plain, window)
+	assert.NoError(t, err)
+	assert.True(t, enabled[1])
+	assert.Equal(t, []ScheduleState{{ID: 1, Name: "s1", Enabled: true}}, report.Changed)
+}
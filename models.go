@@ -20,6 +20,13 @@
 package awx
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -47,6 +54,12 @@ func (e *Error) Error() string {
 	return "status: " + strconv.Itoa(e.StatusCode) + ", messages: " + strings.Join(e.Msg, ", ")
 }
 
+// Is reports whether target is ErrNotFound and the error has status 404,
+// so that errors.Is(err, ErrNotFound) holds for missing objects.
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
 // NewError creates a new error.
 func NewError(statusCode int, msg []string) *Error {
 	return &Error{statusCode, msg}
@@ -59,13 +72,153 @@ type ObjectKey struct {
 	Action     string
 }
 
+// String returns the API path of the key. AWX redirects paths without a
+// trailing slash, which turns a POST into a GET, so the path always ends
+// with one.
 func (k ObjectKey) String() string {
 	path := k.Resource
 	if k.ResourceID != "" {
-		path = path + "/" + k.ResourceID
+		path = strings.TrimSuffix(path, "/") + "/" + k.ResourceID
 	}
 	if k.Action != "" {
-		path = path + "/" + k.Action + "/"
+		path = strings.TrimSuffix(path, "/") + "/" + k.Action
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
 	}
 	return path
 }
+
+// queryEncoder is implemented by list options which encode themselves into
+// query values instead of relying on their schema tags.
+type queryEncoder interface {
+	EncodeQuery(values url.Values) error
+}
+
+// encodeQuery encodes the list options opts into values.
+func encodeQuery(opts ListOption, values url.Values) error {
+	if v := reflect.ValueOf(opts); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	switch o := opts.(type) {
+	case nil:
+		return nil
+	case queryEncoder:
+		return o.EncodeQuery(values)
+	default:
+		return encode.Encode(opts, values)
+	}
+}
+
+// pageOptions adds pagination to the list options opts.
+type pageOptions struct {
+	opts     ListOption
+	page     int
+	pageSize int
+}
+
+func (p pageOptions) EncodeQuery(values url.Values) error {
+	if err := encodeQuery(p.opts, values); err != nil {
+		return err
+	}
+	values.Set("page", strconv.Itoa(p.page))
+	// A page size requested by the options, e.g. a Filter, takes precedence.
+	if !values.Has("page_size") {
+		values.Set("page_size", strconv.Itoa(p.pageSize))
+	}
+	return nil
+}
+
+// maxPageSize is the largest page size AWX accepts by default.
+const maxPageSize = 200
+
+// listAll retrieves all pages of the list at key matching opts.
+func listAll[T any](ctx context.Context, c Reader, key ObjectKey, opts ListOption) ([]*T, error) {
+	var all []*T
+	for page := 1; ; page++ {
+		list := struct {
+			ListGetResponse
+			Results []*T `json:"results,omitempty"`
+		}{}
+		err := c.List(ctx, key, &list, pageOptions{opts: opts, page: page, pageSize: maxPageSize}, nil)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.Results...)
+		if list.Next == "" {
+			return all, nil
+		}
+	}
+}
+
+// idKey returns the key of the object with the given id in resource.
+func idKey(resource string, id int) ObjectKey {
+	return ObjectKey{Resource: resource, ResourceID: strconv.Itoa(id)}
+}
+
+// getByID retrieves the object with the given id from resource.
+func getByID[T any](ctx context.Context, c Reader, resource string, id int) (*T, error) {
+	obj := new(T)
+	if err := c.Get(ctx, idKey(resource, id), obj, nil); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// exchange sends in as request body and decodes the response into out, for
+// actions whose response differs from their input (e.g. launch).
+type exchange struct {
+	in  any
+	out any
+}
+
+func (e *exchange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.in)
+}
+
+func (e *exchange) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, e.out)
+}
+
+// post sends in to the action at key and decodes the response into out.
+func post(ctx context.Context, c Writer, key ObjectKey, in, out any, httpStatus []int) error {
+	return c.Create(ctx, key, &exchange{in: in, out: out}, httpStatus)
+}
+
+// resourceOptions represents the OPTIONS response of a resource.
+type resourceOptions struct {
+	// Actions maps the allowed methods to the fields they accept.
+	Actions map[string]map[string]fieldOptions `json:"actions"`
+}
+
+// fieldOptions represents the description of a field in an OPTIONS response.
+type fieldOptions struct {
+	Type     string  `json:"type"`
+	Required bool    `json:"required"`
+	Choices  [][]any `json:"choices"`
+}
+
+// getFieldChoices retrieves the values accepted for field by method on the
+// resource at key, as described by its OPTIONS response. c must implement
+// OptionsReader.
+func getFieldChoices(ctx context.Context, c Reader, key ObjectKey, method, field string) ([]string, error) {
+	o, ok := c.(OptionsReader)
+	if !ok {
+		return nil, fmt.Errorf("describing %s: %w", key.String(), errors.ErrUnsupported)
+	}
+	options := resourceOptions{}
+	if err := o.Options(ctx, key, &options, nil); err != nil {
+		return nil, err
+	}
+	f, ok := options.Actions[method][field]
+	if !ok {
+		return nil, fmt.Errorf("%s %s: field %s is not described", method, key.String(), field)
+	}
+	choices := make([]string, 0, len(f.Choices))
+	for _, choice := range f.Choices {
+		if len(choice) > 0 {
+			choices = append(choices, fmt.Sprint(choice[0]))
+		}
+	}
+	return choices, nil
+}
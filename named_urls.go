@@ -0,0 +1,164 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when no object matches a lookup. Errors of
+	// the AWX API with status 404 match it as well.
+	ErrNotFound = errors.New("object not found")
+	// ErrAmbiguous is returned when several objects match a lookup which
+	// should identify a single one.
+	ErrAmbiguous = errors.New("object is ambiguous")
+)
+
+const (
+	namedURLSeparator      = "++"
+	namedURLInnerSeparator = "+"
+	// namedURLReserved holds the characters AWX percent-encodes in the
+	// fields of named URLs, and the percent sign itself.
+	namedURLReserved = ";/?:@=&[]%"
+)
+
+// EscapeNamedURLField escapes a field of a named URL the way AWX does: the
+// reserved URL characters, including the brackets, are percent-encoded and
+// "+" is written as "[+]", so that it is not taken for a separator. Other
+// characters not allowed in a path, e.g. spaces, are percent-encoded too.
+func EscapeNamedURLField(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r < 0x80 && strings.ContainsRune(namedURLReserved, r):
+			fmt.Fprintf(&b, "%%%02X", r)
+		case r == '+':
+			b.WriteString("[+]")
+		default:
+			b.WriteString(url.PathEscape(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// NamedURL returns the named URL identifying an object by the identifying
+// fields of the object and of the objects it belongs to, e.g.
+// NamedURL([]string{"deploy"}, []string{"Default"}) is "deploy++Default" and
+// a credential is identified by
+// NamedURL([]string{"ssh"}, []string{"Machine", "ssh"}, []string{"Default"}).
+// An empty component stands for an unset relation, e.g. a credential
+// without organization.
+func NamedURL(components ...[]string) string {
+	parts := make([]string, len(components))
+	for i, fields := range components {
+		escaped := make([]string, len(fields))
+		for j, f := range fields {
+			escaped[j] = EscapeNamedURLField(f)
+		}
+		parts[i] = strings.Join(escaped, namedURLInnerSeparator)
+	}
+	return strings.Join(parts, namedURLSeparator)
+}
+
+// NamedKey returns the key of the object of resource identified by name and
+// the names of the objects it belongs to, e.g.
+// NamedKey("job_templates", "deploy", "Default") for job_templates/deploy++Default/
+// or NamedKey("hosts", "web01", "prod", "Default") for a host of the
+// inventory prod.
+func NamedKey(resource, name string, parents ...string) ObjectKey {
+	components := [][]string{{name}}
+	for _, p := range parents {
+		components = append(components, []string{p})
+	}
+	return ObjectKey{Resource: resource, ResourceID: NamedURL(components...)}
+}
+
+// namedURLFormats lists the resources whose named URL is the name of the
+// object, optionally followed by the name of its organization.
+var namedURLFormats = map[string]struct {
+	nameField    string
+	organization bool
+}{
+	"organizations":          {nameField: "name"},
+	"users":                  {nameField: "username"},
+	"instance_groups":        {nameField: "name"},
+	"teams":                  {nameField: "name", organization: true},
+	"projects":               {nameField: "name", organization: true},
+	"inventories":            {nameField: "name", organization: true},
+	"job_templates":          {nameField: "name", organization: true},
+	"workflow_job_templates": {nameField: "name", organization: true},
+	"labels":                 {nameField: "name", organization: true},
+	"notification_templates": {nameField: "name", organization: true},
+}
+
+// GetByName retrieves the object of resource with the given name. If
+// organization is set, only the objects of that organization are
+// considered. When the named URL of the resource is known, the object is
+// retrieved through it, otherwise the objects are filtered by name. It
+// returns an error matching ErrNotFound if no object matches and
+// ErrAmbiguous if several objects match, e.g. the same name in different
+// organizations.
+func GetByName[T any](ctx context.Context, c Client, resource, name, organization string) (*T, error) {
+	format, known := namedURLFormats[resource]
+	if known && format.organization == (organization != "") {
+		var key ObjectKey
+		if organization != "" {
+			key = NamedKey(resource, name, organization)
+		} else {
+			key = NamedKey(resource, name)
+		}
+		obj := new(T)
+		err := c.Get(ctx, key, obj, nil)
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s %q", ErrNotFound, resource, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+
+	nameField := "name"
+	if known {
+		nameField = format.nameField
+	}
+	filter := NewFilter(Field(nameField).Eq(name)).PageSize(2)
+	if organization != "" {
+		filter.Where(Related("organization", "name").Eq(organization))
+	}
+	list := struct {
+		ListGetResponse
+		Results []*T `json:"results,omitempty"`
+	}{}
+	if err := c.List(ctx, ObjectKey{Resource: resource}, &list, filter, nil); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(list.Results) == 0:
+		return nil, fmt.Errorf("%w: %s %q", ErrNotFound, resource, name)
+	case len(list.Results) > 1 || list.Count > 1:
+		return nil, fmt.Errorf("%w: %d %s named %q", ErrAmbiguous, max(list.Count, len(list.Results)), resource, name)
+	}
+	return list.Results[0], nil
+}
@@ -0,0 +1,106 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedURL(t *testing.T) {
+	assert.Equal(t, "deploy++Default", NamedURL([]string{"deploy"}, []string{"Default"}))
+	assert.Equal(t, "ssh++Machine+ssh++", NamedURL([]string{"ssh"}, []string{"Machine", "ssh"}, nil))
+	assert.Equal(t, "a[+]b%5Bc%5D++R%26D%2FOps", NamedURL([]string{"a+b[c]"}, []string{"R&D/Ops"}))
+	assert.Equal(t, "deploy%20app%2550", EscapeNamedURLField("deploy app%50"))
+	assert.Equal(t, "hosts/web01++prod++Default/", NamedKey("hosts", "web01", "prod", "Default").String())
+}
+
+func TestGetByName(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		var body string
+		switch r.URL.EscapedPath() {
+		case "/job_templates/deploy[+]v2%20eu++Default/":
+			body = `{"id": 1, "name": "deploy+v2 eu"}`
+		case "/organizations/Default/":
+			body = `{"id": 2, "name": "Default"}`
+		case "/job_templates/":
+			switch r.URL.Query().Get("name") {
+			case "deploy":
+				body = `{"count": 2, "results": [{"id": 1, "name": "deploy"}, {"id": 3, "name": "deploy"}]}`
+			case "cleanup":
+				body = `{"count": 1, "results": [{"id": 4, "name": "cleanup"}]}`
+			default:
+				body = `{"count": 0, "results": []}`
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			body = `{"detail": "Not found."}`
+		}
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	jt, err := GetJobTemplateByName(ctx, client, "deploy+v2 eu", "Default")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, jt.ID)
+
+	org, err := GetOrganizationByName(ctx, client, "Default")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, org.ID)
+
+	_, err = GetJobTemplateByName(ctx, client, "missing", "Default")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	jt, err = GetJobTemplateByName(ctx, client, "cleanup", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, jt.ID)
+
+	_, err = GetJobTemplateByName(ctx, client, "deploy", "")
+	assert.ErrorIs(t, err, ErrAmbiguous)
+
+	_, err = GetJobTemplateByName(ctx, client, "missing", "")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = GetJob(ctx, client, 99)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.Equal(t, []string{
+		"/job_templates/deploy[+]v2%20eu++Default/?",
+		"/organizations/Default/?",
+		"/job_templates/missing++Default/?",
+		"/job_templates/?name=cleanup&page_size=2",
+		"/job_templates/?name=deploy&page_size=2",
+		"/job_templates/?name=missing&page_size=2",
+		"/jobs/99/?",
+	}, paths)
+}
@@ -0,0 +1,171 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"strconv"
+)
+
+// OrganizationList represents the output of the ListOrganizations method.
+type OrganizationList struct {
+	ListGetResponse
+	Results []*Organization `json:"results,omitempty"`
+}
+
+// Organization represents the output of the GetOrganizations method.
+type Organization struct {
+	ID                 int    `json:"id,omitempty"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	URL                string `json:"url,omitempty"`
+	Type               string `json:"type,omitempty"`
+	Modified           string `json:"modified,omitempty"`
+	Created            string `json:"created,omitempty"`
+	MaxHosts           int    `json:"max_hosts"`
+	DefaultEnvironment *int   `json:"default_environment"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListOrganizationsInput represents the input of the ListOrganizations method.
+type ListOrganizationsInput struct {
+	ID   string `schema:"id,omitempty"`
+	Name string `schema:"name,omitempty"`
+}
+
+const organizationsResource = "organizations"
+
+func organizationSubKey(orgID int, action string) ObjectKey {
+	return ObjectKey{Resource: organizationsResource, ResourceID: strconv.Itoa(orgID), Action: action}
+}
+
+// GetOrganization retrieves the organization with the given id.
+func GetOrganization(ctx context.Context, c Client, id int) (*Organization, error) {
+	return getByID[Organization](ctx, c, organizationsResource, id)
+}
+
+// GetOrganizationByName retrieves the organization with the given name. The
+// error matches ErrNotFound if it does not exist.
+func GetOrganizationByName(ctx context.Context, c Client, name string) (*Organization, error) {
+	return GetByName[Organization](ctx, c, organizationsResource, name, "")
+}
+
+// ListOrganizations retrieves all organizations matching input.
+func ListOrganizations(ctx context.Context, c Client, input *ListOrganizationsInput) ([]*Organization, error) {
+	return listAll[Organization](ctx, c, ObjectKey{Resource: organizationsResource}, input)
+}
+
+// CreateOrganization creates org in AWX and updates it with the response of the server.
+func CreateOrganization(ctx context.Context, c Client, org *Organization) error {
+	return c.Create(ctx, ObjectKey{Resource: organizationsResource}, org, nil)
+}
+
+// UpdateOrganization updates org in AWX.
+func UpdateOrganization(ctx context.Context, c Client, org *Organization) error {
+	return c.Update(ctx, idKey(organizationsResource, org.ID), org, nil)
+}
+
+// DeleteOrganization deletes the organization with the given id.
+func DeleteOrganization(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(organizationsResource, id), nil)
+}
+
+// ListOrganizationUsers retrieves the members of the organization.
+func ListOrganizationUsers(ctx context.Context, c Client, orgID int) ([]*User, error) {
+	return listAll[User](ctx, c, organizationSubKey(orgID, "users"), nil)
+}
+
+// AddOrganizationUser makes the user a member of the organization.
+func AddOrganizationUser(ctx context.Context, c Client, orgID, userID int) error {
+	return Associate(ctx, c, organizationSubKey(orgID, "users"), userID)
+}
+
+// RemoveOrganizationUser removes the user from the members of the organization.
+func RemoveOrganizationUser(ctx context.Context, c Client, orgID, userID int) error {
+	return Disassociate(ctx, c, organizationSubKey(orgID, "users"), userID)
+}
+
+// ListOrganizationAdmins retrieves the admins of the organization.
+func ListOrganizationAdmins(ctx context.Context, c Client, orgID int) ([]*User, error) {
+	return listAll[User](ctx, c, organizationSubKey(orgID, "admins"), nil)
+}
+
+// AddOrganizationAdmin makes the user an admin of the organization.
+func AddOrganizationAdmin(ctx context.Context, c Client, orgID, userID int) error {
+	return Associate(ctx, c, organizationSubKey(orgID, "admins"), userID)
+}
+
+// RemoveOrganizationAdmin removes the user from the admins of the organization.
+func RemoveOrganizationAdmin(ctx context.Context, c Client, orgID, userID int) error {
+	return Disassociate(ctx, c, organizationSubKey(orgID, "admins"), userID)
+}
+
+// ListOrganizationTeams retrieves the teams of the organization.
+func ListOrganizationTeams(ctx context.Context, c Client, orgID int) ([]*Team, error) {
+	return listAll[Team](ctx, c, organizationSubKey(orgID, "teams"), nil)
+}
+
+// ListOrganizationGalaxyCredentials retrieves the galaxy credentials of the
+// organization in the order they are used.
+func ListOrganizationGalaxyCredentials(ctx context.Context, c Client, orgID int) ([]*Credential, error) {
+	return listAll[Credential](ctx, c, organizationSubKey(orgID, "galaxy_credentials"), nil)
+}
+
+// SetOrganizationGalaxyCredentials makes the galaxy credentials of the
+// organization exactly credentialIDs, in that order. AWX appends associated
+// credentials, so credentials are only kept when the existing order is a
+// prefix of credentialIDs.
+func SetOrganizationGalaxyCredentials(ctx context.Context, c Client, orgID int, credentialIDs []int) error {
+	current, err := ListOrganizationGalaxyCredentials(ctx, c, orgID)
+	if err != nil {
+		return err
+	}
+	key := organizationSubKey(orgID, "galaxy_credentials")
+	keep := 0
+	for keep < len(current) && keep < len(credentialIDs) && current[keep].ID == credentialIDs[keep] {
+		keep++
+	}
+	for _, cred := range current[keep:] {
+		if err = Disassociate(ctx, c, key, cred.ID); err != nil {
+			return err
+		}
+	}
+	for _, id := range credentialIDs[keep:] {
+		if err = Associate(ctx, c, key, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetOrganizationDefaultEnvironment sets the default execution environment of
+// the organization, nil removes it.
+func SetOrganizationDefaultEnvironment(ctx context.Context, c Client, orgID int, environmentID *int) error {
+	org, err := GetOrganization(ctx, c, orgID)
+	if err != nil {
+		return err
+	}
+	if org.DefaultEnvironment == nil && environmentID == nil ||
+		org.DefaultEnvironment != nil && environmentID != nil && *org.DefaultEnvironment == *environmentID {
+		return nil
+	}
+	org.DefaultEnvironment = environmentID
+	return UpdateOrganization(ctx, c, org)
+}
@@ -0,0 +1,85 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationMembership(t *testing.T) {
+	var calls []AssociateInput
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /organizations/1/admins/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 5, "username": "alice"}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /organizations/1/galaxy_credentials/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 2, "results": [{"id": 2, "name": "galaxy"}, {"id": 3, "name": "automation hub"}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /{resource}/{id}/{list}/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		calls = append(calls, received)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	admins, err := ListOrganizationAdmins(context.Background(), client, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", admins[0].Username)
+
+	err = AddTeamUser(context.Background(), client, 4, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []AssociateInput{{ID: 5}}, calls)
+
+	calls = nil
+	err = SetOrganizationGalaxyCredentials(context.Background(), client, 1, []int{2, 4, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []AssociateInput{
+		{ID: 3, Disassociate: true},
+		{ID: 4},
+		{ID: 3},
+	}, calls)
+}
+
+func TestUserPasswordIsMasked(t *testing.T) {
+	user := User{Username: "bob", Password: "hunter2"}
+	data, err := json.Marshal(user)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"password":"hunter2"`)
+	assert.NotContains(t, user.Password.String(), "hunter2")
+}
@@ -0,0 +1,210 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Project SCM types supported by AWX. Manual projects have an empty SCM type.
+const (
+	ProjectSCMManual   = ""
+	ProjectSCMGit      = "git"
+	ProjectSCMSvn      = "svn"
+	ProjectSCMInsights = "insights"
+	ProjectSCMArchive  = "archive"
+)
+
+// ProjectList represents the output of the ListProjects method.
+type ProjectList struct {
+	ListGetResponse
+	Results []*Project `json:"results,omitempty"`
+}
+
+// Project represents the output of the GetProjects method.
+type Project struct {
+	ID                    int    `json:"id,omitempty"`
+	Name                  string `json:"name"`
+	Description           string `json:"description"`
+	URL                   string `json:"url,omitempty"`
+	Type                  string `json:"type,omitempty"`
+	Modified              string `json:"modified,omitempty"`
+	Created               string `json:"created,omitempty"`
+	Organization          int    `json:"organization"`
+	LocalPath             string `json:"local_path,omitempty"`
+	SCMType               string `json:"scm_type"`
+	SCMURL                string `json:"scm_url"`
+	SCMBranch             string `json:"scm_branch"`
+	SCMRefspec            string `json:"scm_refspec"`
+	SCMClean              bool   `json:"scm_clean"`
+	SCMTrackSubmodules    bool   `json:"scm_track_submodules"`
+	SCMDeleteOnUpdate     bool   `json:"scm_delete_on_update"`
+	SCMUpdateOnLaunch     bool   `json:"scm_update_on_launch"`
+	SCMUpdateCacheTimeout int    `json:"scm_update_cache_timeout"`
+	SCMRevision           string `json:"scm_revision,omitempty"`
+	Credential            *int   `json:"credential"`
+	DefaultEnvironment    *int   `json:"default_environment"`
+	AllowOverride         bool   `json:"allow_override"`
+	Timeout               int    `json:"timeout"`
+	Status                string `json:"status,omitempty"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListProjectsInput represents the input of the ListProjects method.
+type ListProjectsInput struct {
+	ID           string `schema:"id,omitempty"`
+	Name         string `schema:"name,omitempty"`
+	Organization int    `schema:"organization,omitempty"`
+}
+
+// ProjectUpdateList represents the output of the ListProjectUpdates method.
+type ProjectUpdateList struct {
+	ListGetResponse
+	Results []*ProjectUpdate `json:"results,omitempty"`
+}
+
+// ProjectUpdate represents the SCM update job of a project.
+type ProjectUpdate struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	URL                string    `json:"url"`
+	Type               string    `json:"type"`
+	Modified           string    `json:"modified"`
+	Created            string    `json:"created"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	Project            int       `json:"project"`
+	JobType            string    `json:"job_type"`
+	LaunchType         string    `json:"launch_type"`
+	Status             string    `json:"status"`
+	Failed             bool      `json:"failed"`
+	Started            time.Time `json:"started"`
+	Finished           time.Time `json:"finished"`
+	Elapsed            float64   `json:"elapsed"`
+	JobExplanation     string    `json:"job_explanation"`
+	SCMBranch          string    `json:"scm_branch"`
+	SCMRevision        string    `json:"scm_revision"`
+}
+
+// SyncProjectOutput represents the output of the SyncProject method.
+type SyncProjectOutput struct {
+	ID            int    `json:"id"`
+	ProjectUpdate int    `json:"project_update"`
+	Status        string `json:"status"`
+}
+
+const (
+	projectsResource       = "projects"
+	projectUpdatesResource = "project_updates"
+)
+
+// GetProject retrieves the project with the given id.
+func GetProject(ctx context.Context, c Client, id int) (*Project, error) {
+	return getByID[Project](ctx, c, projectsResource, id)
+}
+
+// GetProjectByName retrieves the project with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several projects match.
+func GetProjectByName(ctx context.Context, c Client, name, organization string) (*Project, error) {
+	return GetByName[Project](ctx, c, "projects", name, organization)
+}
+
+// ListProjects retrieves all projects matching input.
+func ListProjects(ctx context.Context, c Client, input *ListProjectsInput) ([]*Project, error) {
+	return listAll[Project](ctx, c, ObjectKey{Resource: projectsResource}, input)
+}
+
+// CreateProject creates project in AWX and updates it with the response of
+// the server. AWX starts an initial SCM update for new projects.
+func CreateProject(ctx context.Context, c Client, project *Project) error {
+	return c.Create(ctx, ObjectKey{Resource: projectsResource}, project, nil)
+}
+
+// UpdateProject updates project in AWX.
+func UpdateProject(ctx context.Context, c Client, project *Project) error {
+	return c.Update(ctx, idKey(projectsResource, project.ID), project, nil)
+}
+
+// DeleteProject deletes the project with the given id.
+func DeleteProject(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(projectsResource, id), []int{http.StatusNoContent, http.StatusAccepted})
+}
+
+// SyncProject starts an SCM update of the project with the given id through
+// its update action and returns the id of the resulting project update.
+func SyncProject(ctx context.Context, c Client, id int) (int, error) {
+	output := SyncProjectOutput{}
+	key := ObjectKey{Resource: projectsResource, ResourceID: strconv.Itoa(id), Action: "update"}
+	if err := post(ctx, c, key, &struct{}{}, &output, []int{http.StatusAccepted, http.StatusCreated}); err != nil {
+		return 0, err
+	}
+	return output.ProjectUpdate, nil
+}
+
+// GetProjectUpdate retrieves the project update with the given id.
+func GetProjectUpdate(ctx context.Context, c Client, id int) (*ProjectUpdate, error) {
+	return getByID[ProjectUpdate](ctx, c, projectUpdatesResource, id)
+}
+
+// WaitProjectUpdate polls the project update with the given id every
+// interval until it finished or ctx is done.
+func WaitProjectUpdate(ctx context.Context, c Client, id int, interval time.Duration) (*ProjectUpdate, error) {
+	return waitFinished(ctx, interval, func() (*ProjectUpdate, string, error) {
+		update, err := GetProjectUpdate(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return update, update.Status, nil
+	})
+}
+
+// GetProjectUpdateStdout retrieves the output of the project update with the given id.
+func GetProjectUpdateStdout(ctx context.Context, c Client, id int) (string, error) {
+	return getStdout(ctx, c, projectUpdatesResource, id)
+}
+
+// ListProjectPlaybooks retrieves the playbooks found in the project with the
+// given id during its last SCM update.
+func ListProjectPlaybooks(ctx context.Context, c Client, id int) ([]string, error) {
+	var playbooks []string
+	key := ObjectKey{Resource: projectsResource, ResourceID: strconv.Itoa(id), Action: "playbooks"}
+	if err := c.Get(ctx, key, &playbooks, nil); err != nil {
+		return nil, err
+	}
+	return playbooks, nil
+}
+
+// ValidateProjectPlaybook checks that the project with the given id contains
+// playbook, e.g. before creating a job template which uses it.
+func ValidateProjectPlaybook(ctx context.Context, c Client, id int, playbook string) error {
+	playbooks, err := ListProjectPlaybooks(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(playbooks, playbook) {
+		return fmt.Errorf("project %d does not contain playbook %q", id, playbook)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncProject(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /projects/6/update/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusAccepted, `{"id": 15, "project_update": 15, "status": "pending"}`)
+	})
+	mux.HandleFunc("GET /project_updates/15/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"id": 15, "project": 6, "status": "successful", "scm_revision": "abc123"}`)
+	})
+	mux.HandleFunc("GET /projects/6/playbooks/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `["site.yml", "deploy/web.yml"]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	updateID, err := SyncProject(context.Background(), client, 6)
+	assert.NoError(t, err)
+	update, err := WaitProjectUpdate(context.Background(), client, updateID, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", update.SCMRevision)
+
+	assert.NoError(t, ValidateProjectPlaybook(context.Background(), client, 6, "deploy/web.yml"))
+	assert.ErrorContains(t, ValidateProjectPlaybook(context.Background(), client, 6, "missing.yml"),
+		`project 6 does not contain playbook "missing.yml"`)
+}
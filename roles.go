@@ -0,0 +1,194 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RoleName represents the name of a role attached to an object, e.g. the
+// execute role of a job template.
+type RoleName string
+
+// Common role names. Which roles exist depends on the kind of object.
+const (
+	RoleAdmin   RoleName = "admin"
+	RoleExecute RoleName = "execute"
+	RoleRead    RoleName = "read"
+	RoleUse     RoleName = "use"
+	RoleUpdate  RoleName = "update"
+	RoleMember  RoleName = "member"
+	RoleAdHoc   RoleName = "adhoc"
+	RoleApprove RoleName = "approve"
+)
+
+// roleName normalizes the display name of a role, e.g. "Ad Hoc", to a RoleName.
+func roleName(displayName string) RoleName {
+	return RoleName(strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(displayName)))
+}
+
+// RoleList represents the output of the ListRoles method.
+type RoleList struct {
+	ListGetResponse
+	Results []*Role `json:"results,omitempty"`
+}
+
+// Role represents a role attached to an object.
+type Role struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	URL           string            `json:"url"`
+	Type          string            `json:"type"`
+	SummaryFields RoleSummaryFields `json:"summary_fields"`
+}
+
+// RoleSummaryFields represents the summary fields of a role.
+type RoleSummaryFields struct {
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   int    `json:"resource_id"`
+}
+
+// RoleName returns the normalized name of the role.
+func (r *Role) RoleName() RoleName {
+	return roleName(r.Name)
+}
+
+// accessListEntry represents a user in the access list of an object.
+type accessListEntry struct {
+	ID            int    `json:"id"`
+	Username      string `json:"username"`
+	SummaryFields struct {
+		DirectAccess   []roleAccess `json:"direct_access"`
+		IndirectAccess []roleAccess `json:"indirect_access"`
+	} `json:"summary_fields"`
+}
+
+// roleAccess represents a role granting access in an access list.
+type roleAccess struct {
+	Role struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"role"`
+	// DescendantRoles holds the role fields on the object implied by the
+	// role, including the role itself, e.g. "admin_role" and "execute_role".
+	DescendantRoles []string `json:"descendant_roles"`
+}
+
+// ListObjectRoles retrieves the roles attached to obj, e.g.
+// ObjectKey{Resource: "job_templates", ResourceID: "1"}.
+func ListObjectRoles(ctx context.Context, c Client, obj ObjectKey) ([]*Role, error) {
+	obj.Action = "object_roles"
+	return listAll[Role](ctx, c, obj, nil)
+}
+
+// GetObjectRole retrieves the role with the given name attached to obj.
+func GetObjectRole(ctx context.Context, c Client, obj ObjectKey, name RoleName) (*Role, error) {
+	roles, err := ListObjectRoles(ctx, c, obj)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		if r.RoleName() == roleName(string(name)) {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s has no %s role", obj.Resource, obj.ResourceID, name)
+}
+
+func roleSubKey(roleID int, action string) ObjectKey {
+	return ObjectKey{Resource: "roles", ResourceID: strconv.Itoa(roleID), Action: action}
+}
+
+// GrantUserRole grants the role with the given name on obj to the user.
+func GrantUserRole(ctx context.Context, c Client, obj ObjectKey, name RoleName, userID int) error {
+	role, err := GetObjectRole(ctx, c, obj, name)
+	if err != nil {
+		return err
+	}
+	return Associate(ctx, c, roleSubKey(role.ID, usersResource), userID)
+}
+
+// RevokeUserRole revokes the role with the given name on obj from the user.
+func RevokeUserRole(ctx context.Context, c Client, obj ObjectKey, name RoleName, userID int) error {
+	role, err := GetObjectRole(ctx, c, obj, name)
+	if err != nil {
+		return err
+	}
+	return Disassociate(ctx, c, roleSubKey(role.ID, usersResource), userID)
+}
+
+// GrantTeamRole grants the role with the given name on obj to the team.
+func GrantTeamRole(ctx context.Context, c Client, obj ObjectKey, name RoleName, teamID int) error {
+	role, err := GetObjectRole(ctx, c, obj, name)
+	if err != nil {
+		return err
+	}
+	return Associate(ctx, c, roleSubKey(role.ID, teamsResource), teamID)
+}
+
+// RevokeTeamRole revokes the role with the given name on obj from the team.
+func RevokeTeamRole(ctx context.Context, c Client, obj ObjectKey, name RoleName, teamID int) error {
+	role, err := GetObjectRole(ctx, c, obj, name)
+	if err != nil {
+		return err
+	}
+	return Disassociate(ctx, c, roleSubKey(role.ID, teamsResource), teamID)
+}
+
+// ListUserObjectRoles returns the roles the user holds on obj, whether
+// granted directly, through a team or through a parent object such as the
+// organization. Roles implied by a held role, e.g. execute by admin, are
+// included. The result is sorted and free of duplicates.
+func ListUserObjectRoles(ctx context.Context, c Client, obj ObjectKey, userID int) ([]RoleName, error) {
+	obj.Action = "access_list"
+	entries, err := listAll[accessListEntry](ctx, c, obj, &ListUsersInput{ID: strconv.Itoa(userID)})
+	if err != nil {
+		return nil, err
+	}
+	var names []RoleName
+	for _, e := range entries {
+		if e.ID != userID {
+			continue
+		}
+		for _, a := range slices.Concat(e.SummaryFields.DirectAccess, e.SummaryFields.IndirectAccess) {
+			names = append(names, roleName(a.Role.Name))
+			for _, field := range a.DescendantRoles {
+				names = append(names, roleName(strings.TrimSuffix(field, "_role")))
+			}
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// UserHasObjectRole reports whether the user holds the role with the given
+// name on obj, directly or implied by another role.
+func UserHasObjectRole(ctx context.Context, c Client, obj ObjectKey, name RoleName, userID int) (bool, error) {
+	names, err := ListUserObjectRoles(ctx, c, obj, userID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(names, roleName(string(name))), nil
+}
@@ -0,0 +1,95 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoles(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	var granted []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /job_templates/1/object_roles/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"results": [
+			{"id": 30, "name": "Admin", "summary_fields": {"resource_name": "deploy", "resource_type": "job_template", "resource_id": 1}},
+			{"id": 31, "name": "Execute"},
+			{"id": 32, "name": "Read"}
+		]}`)
+	})
+	mux.HandleFunc("POST /roles/{id}/{list}/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.False(t, received.Disassociate)
+		granted = append(granted, r.PathValue("id")+"/"+r.PathValue("list"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /job_templates/1/access_list/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5", r.URL.Query().Get("id"))
+		writeJSON(w, http.StatusOK, `{"results": [{"id": 5, "username": "alice", "summary_fields": {
+			"direct_access": [{"role": {"id": 31, "name": "Execute"}, "descendant_roles": ["execute_role"]}],
+			"indirect_access": [
+				{"role": {"id": 30, "name": "Admin"}, "descendant_roles": ["admin_role", "execute_role", "read_role"]},
+				{"role": {"id": 31, "name": "Execute"}, "descendant_roles": ["execute_role"]}
+			]
+		}}]}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	tpl := ObjectKey{Resource: "job_templates", ResourceID: "1"}
+	err = GrantUserRole(context.Background(), client, tpl, RoleExecute, 5)
+	assert.NoError(t, err)
+	err = GrantTeamRole(context.Background(), client, tpl, RoleRead, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"31/users", "32/teams"}, granted)
+
+	err = GrantUserRole(context.Background(), client, tpl, RoleUse, 5)
+	assert.ErrorContains(t, err, "job_templates 1 has no use role")
+
+	roles, err := ListUserObjectRoles(context.Background(), client, tpl, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []RoleName{RoleAdmin, RoleExecute, RoleRead}, roles)
+
+	// The read role is only implied by the admin role granted through a team.
+	ok, err := UserHasObjectRole(context.Background(), client, tpl, RoleRead, 5)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = UserHasObjectRole(context.Background(), client, tpl, RoleApprove, 5)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
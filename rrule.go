@@ -0,0 +1,862 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency represents the FREQ of a recurrence rule.
+type Frequency string
+
+// Frequencies accepted by AWX. SECONDLY is not supported.
+const (
+	FrequencyMinutely Frequency = "MINUTELY"
+	FrequencyHourly   Frequency = "HOURLY"
+	FrequencyDaily    Frequency = "DAILY"
+	FrequencyWeekly   Frequency = "WEEKLY"
+	FrequencyMonthly  Frequency = "MONTHLY"
+	FrequencyYearly   Frequency = "YEARLY"
+)
+
+// MaxRRuleCount is the largest COUNT accepted by AWX.
+const MaxRRuleCount = 999
+
+const (
+	rruleUTCLayout   = "20060102T150405Z"
+	rruleLocalLayout = "20060102T150405"
+)
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// weekdayCode returns the RRULE code of d, or its number if it is out of
+// range so that invalid rules still render and fail Validate.
+func weekdayCode(d time.Weekday) string {
+	if d < time.Sunday || d > time.Saturday {
+		return strconv.Itoa(int(d))
+	}
+	return weekdayCodes[d]
+}
+
+// Recurrence represents the rrule of an AWX schedule: a start time and one or
+// more recurrence rules, optionally with exclusion rules, e.g.
+//
+//	DTSTART;TZID=Europe/Berlin:20250101T030000 RRULE:FREQ=WEEKLY;BYDAY=MO,TH
+type Recurrence struct {
+	// DTStart is the first occurrence. Its location is rendered as TZID,
+	// UTC is rendered with a Z suffix.
+	DTStart time.Time
+	RRules  []*RRule
+	ExRules []*RRule
+}
+
+// RRule represents a single RRULE or EXRULE.
+type RRule struct {
+	Freq       Frequency
+	Interval   int // required by AWX, must be at least 1
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []time.Weekday
+	ByHour     []int
+	ByMinute   []int
+	BySetPos   []int
+	WeekStart  *time.Weekday
+	Count      int
+	// Until is the last possible occurrence, it is always rendered in UTC.
+	Until time.Time
+}
+
+// NewRecurrence returns a recurrence starting at start with the given rules.
+func NewRecurrence(start time.Time, rules ...*RRule) *Recurrence {
+	return &Recurrence{DTStart: start, RRules: rules}
+}
+
+// ParseRecurrence parses the rrule of an AWX schedule. Parsing only checks
+// the syntax, use Validate to check the restrictions of AWX.
+func ParseRecurrence(s string) (*Recurrence, error) {
+	r := &Recurrence{}
+	dtstarts := 0
+	for _, token := range strings.Fields(s) {
+		name, value, ok := strings.Cut(token, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rrule token %q", token)
+		}
+		name, params, _ := strings.Cut(name, ";")
+		switch strings.ToUpper(name) {
+		case "DTSTART":
+			dtstarts++
+			start, err := parseDTStart(params, value)
+			if err != nil {
+				return nil, err
+			}
+			r.DTStart = start
+		case "RRULE", "EXRULE":
+			rule, err := ParseRRule(value)
+			if err != nil {
+				return nil, err
+			}
+			if strings.EqualFold(name, "RRULE") {
+				r.RRules = append(r.RRules, rule)
+			} else {
+				r.ExRules = append(r.ExRules, rule)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rrule property %s", strings.ToUpper(name))
+		}
+	}
+	if dtstarts > 1 {
+		return nil, errors.New("multiple DTSTART is not supported")
+	}
+	return r, nil
+}
+
+func parseDTStart(params, value string) (time.Time, error) {
+	if params == "" {
+		if !strings.HasSuffix(value, "Z") {
+			return time.Time{}, errors.New("DTSTART cannot be a naive datetime, specify ;TZID= or a UTC time with Z suffix")
+		}
+		t, err := time.Parse(rruleUTCLayout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTSTART %q", value)
+		}
+		return t, nil
+	}
+	key, tzid, _ := strings.Cut(params, "=")
+	if !strings.EqualFold(key, "TZID") {
+		return time.Time{}, fmt.Errorf("unsupported DTSTART parameter %s", params)
+	}
+	loc, err := time.LoadLocation(tzid)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+	}
+	t, err := time.ParseInLocation(rruleLocalLayout, strings.TrimSuffix(value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DTSTART %q", value)
+	}
+	return t, nil
+}
+
+// ParseRRule parses the value of a single RRULE or EXRULE, e.g.
+// "FREQ=DAILY;INTERVAL=2;BYHOUR=3".
+func ParseRRule(s string) (*RRule, error) {
+	r := &RRule{}
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(value)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(value)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(value)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(value)
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wd, err := parseWeekday(day)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "WKST":
+			wd, err := parseWeekday(value)
+			if err != nil {
+				return nil, err
+			}
+			r.WeekStart = &wd
+		default:
+			return nil, fmt.Errorf("%s not supported", strings.ToUpper(key))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule part %q: %w", part, err)
+		}
+	}
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse(rruleUTCLayout, value)
+	}
+	if len(value) == len("20060102") {
+		return time.Parse("20060102", value)
+	}
+	return time.Time{}, errors.New("UNTIL must be a UTC time with Z suffix")
+}
+
+func parseInts(value string) ([]int, error) {
+	var out []int
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToUpper(s)
+	if i := slices.Index(weekdayCodes, s); i >= 0 {
+		return time.Weekday(i), nil
+	}
+	if len(s) > 2 && slices.Contains(weekdayCodes, s[len(s)-2:]) {
+		return 0, errors.New("BYDAY with numeric prefix not supported")
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+// String renders the recurrence in the format expected by AWX.
+func (r *Recurrence) String() string {
+	parts := []string{formatDTStart(r.DTStart)}
+	for _, rule := range r.RRules {
+		parts = append(parts, "RRULE:"+rule.String())
+	}
+	for _, rule := range r.ExRules {
+		parts = append(parts, "EXRULE:"+rule.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatDTStart(t time.Time) string {
+	if t.Location() == time.UTC {
+		return "DTSTART:" + t.Format(rruleUTCLayout)
+	}
+	return "DTSTART;TZID=" + t.Location().String() + ":" + t.Format(rruleLocalLayout)
+}
+
+// String renders the rule without its RRULE: or EXRULE: prefix.
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	if r.WeekStart != nil {
+		parts = append(parts, "WKST="+weekdayCode(*r.WeekStart))
+	}
+	for _, p := range []struct {
+		name   string
+		values []int
+	}{
+		{"BYMONTH", r.ByMonth},
+		{"BYMONTHDAY", r.ByMonthDay},
+	} {
+		if len(p.values) > 0 {
+			parts = append(parts, p.name+"="+joinInts(p.values))
+		}
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = weekdayCode(d)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	for _, p := range []struct {
+		name   string
+		values []int
+	}{
+		{"BYHOUR", r.ByHour},
+		{"BYMINUTE", r.ByMinute},
+		{"BYSETPOS", r.BySetPos},
+	} {
+		if len(p.values) > 0 {
+			parts = append(parts, p.name+"="+joinInts(p.values))
+		}
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleUTCLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
+
+// Validate checks the recurrence against the restrictions AWX applies to
+// schedule rrules, so that invalid rules are caught without a server.
+func (r *Recurrence) Validate() error {
+	var errs []error
+	if r.DTStart.IsZero() {
+		errs = append(errs, errors.New("valid DTSTART required in rrule"))
+	} else if name := r.DTStart.Location().String(); r.DTStart.Location() != time.UTC {
+		if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+			errs = append(errs, fmt.Errorf("DTSTART time zone %q is not a valid TZID", name))
+		}
+	}
+	if len(r.RRules) == 0 {
+		errs = append(errs, errors.New("one or more rule required in rrule"))
+	}
+	for _, rule := range slices.Concat(r.RRules, r.ExRules) {
+		errs = append(errs, rule.Validate())
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the rule against the restrictions of AWX.
+func (r *RRule) Validate() error {
+	var errs []error
+	switch r.Freq {
+	case FrequencyMinutely, FrequencyHourly, FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	case "":
+		errs = append(errs, errors.New("FREQ required in rrule"))
+	case "SECONDLY":
+		errs = append(errs, errors.New("SECONDLY is not supported"))
+	default:
+		errs = append(errs, fmt.Errorf("invalid FREQ %q", r.Freq))
+	}
+	switch {
+	case r.Interval == 0:
+		errs = append(errs, errors.New("INTERVAL required in rrule"))
+	case r.Interval < 0:
+		errs = append(errs, errors.New("INTERVAL must be positive"))
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		errs = append(errs, errors.New("RRULE may not contain both COUNT and UNTIL"))
+	}
+	if r.Count > MaxRRuleCount {
+		errs = append(errs, fmt.Errorf("COUNT > %d is unsupported", MaxRRuleCount))
+	}
+	if r.Count < 0 {
+		errs = append(errs, errors.New("COUNT must be positive"))
+	}
+	checkRange := func(name string, values []int, low, high int, allowNegative bool) {
+		for _, v := range values {
+			if v == 0 && low > 0 || v > high || v < low && !(allowNegative && v < 0 && -v <= high) {
+				errs = append(errs, fmt.Errorf("%s value %d out of range", name, v))
+			}
+		}
+	}
+	checkRange("BYMONTH", r.ByMonth, 1, 12, false)
+	checkRange("BYMONTHDAY", r.ByMonthDay, 1, 31, true)
+	checkRange("BYHOUR", r.ByHour, 0, 23, false)
+	checkRange("BYMINUTE", r.ByMinute, 0, 59, false)
+	checkRange("BYSETPOS", r.BySetPos, 1, 366, true)
+	for _, d := range r.ByDay {
+		if d < time.Sunday || d > time.Saturday {
+			errs = append(errs, fmt.Errorf("BYDAY value %d out of range", d))
+		}
+	}
+	if r.WeekStart != nil && (*r.WeekStart < time.Sunday || *r.WeekStart > time.Saturday) {
+		errs = append(errs, fmt.Errorf("WKST value %d out of range", *r.WeekStart))
+	}
+	return errors.Join(errs...)
+}
+
+// Describe returns a human readable description of the recurrence, e.g.
+// "every 2 weeks on Monday and Thursday at 03:00, 10 times, starting
+// 2025-01-01 03:00 CET (Europe/Berlin)".
+func (r *Recurrence) Describe() string {
+	var rules []string
+	for _, rule := range r.RRules {
+		rules = append(rules, rule.Describe())
+	}
+	s := strings.Join(rules, "; and ")
+	if len(r.ExRules) > 0 {
+		var ex []string
+		for _, rule := range r.ExRules {
+			ex = append(ex, rule.Describe())
+		}
+		s += "; except " + strings.Join(ex, "; and ")
+	}
+	if !r.DTStart.IsZero() {
+		s += ", starting " + r.DTStart.Format("2006-01-02 15:04 MST")
+		if name := r.DTStart.Location().String(); name != "UTC" {
+			s += " (" + name + ")"
+		}
+	}
+	return s
+}
+
+// Describe returns a human readable description of the rule.
+func (r *RRule) Describe() string {
+	units := map[Frequency]string{
+		FrequencyMinutely: "minute",
+		FrequencyHourly:   "hour",
+		FrequencyDaily:    "day",
+		FrequencyWeekly:   "week",
+		FrequencyMonthly:  "month",
+		FrequencyYearly:   "year",
+	}
+	unit, ok := units[r.Freq]
+	if !ok {
+		unit = strings.ToLower(string(r.Freq))
+	}
+	s := "every " + unit
+	if r.Interval > 1 {
+		s = "every " + strconv.Itoa(r.Interval) + " " + unit + "s"
+	}
+	if len(r.BySetPos) > 0 {
+		var pos []string
+		for _, p := range r.BySetPos {
+			pos = append(pos, ordinal(p))
+		}
+		s += " on the " + joinWords(pos) + " occurrence of"
+	}
+	if len(r.ByMonth) > 0 {
+		var months []string
+		for _, m := range r.ByMonth {
+			if m >= 1 && m <= 12 {
+				months = append(months, time.Month(m).String())
+			}
+		}
+		s += " in " + joinWords(months)
+	}
+	if len(r.ByMonthDay) > 0 {
+		var days []string
+		for _, d := range r.ByMonthDay {
+			days = append(days, ordinal(d))
+		}
+		s += " on the " + joinWords(days) + " day of the month"
+	}
+	if len(r.ByDay) > 0 {
+		var days []string
+		for _, d := range r.ByDay {
+			days = append(days, d.String())
+		}
+		s += " on " + joinWords(days)
+	}
+	switch {
+	case len(r.ByHour) > 0:
+		minutes := r.ByMinute
+		if len(minutes) == 0 {
+			minutes = []int{0}
+		}
+		var times []string
+		for _, h := range r.ByHour {
+			for _, m := range minutes {
+				times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+			}
+		}
+		s += " at " + joinWords(times)
+	case len(r.ByMinute) > 0:
+		var minutes []string
+		for _, m := range r.ByMinute {
+			minutes = append(minutes, strconv.Itoa(m))
+		}
+		s += " at minute " + joinWords(minutes)
+	}
+	if r.Count == 1 {
+		s += ", once"
+	} else if r.Count > 1 {
+		s += ", " + strconv.Itoa(r.Count) + " times"
+	}
+	if !r.Until.IsZero() {
+		s += ", until " + r.Until.UTC().Format("2006-01-02 15:04 UTC")
+	}
+	return s
+}
+
+func ordinal(n int) string {
+	if n == -1 {
+		return "last"
+	}
+	if n < 0 {
+		return ordinal(-n) + " to last"
+	}
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+func joinWords(words []string) string {
+	if len(words) <= 1 {
+		return strings.Join(words, "")
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
+
+// Recurrence parses the rrule of the schedule.
+func (s *Schedule) Recurrence() (*Recurrence, error) {
+	return ParseRecurrence(s.RRULE)
+}
+
+// SetRecurrence validates r and sets it as rrule of the schedule input.
+func (in *ScheduleInput) SetRecurrence(r *Recurrence) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	in.RRULE = r.String()
+	return nil
+}
+
+// maxEmptyPeriods bounds the number of consecutive periods without an
+// occurrence of MINUTELY and HOURLY rules, so that rules which can never
+// match, e.g. the 30th of February, do not loop forever.
+const maxEmptyPeriods = 1000000
+
+// ErrSearchLimit is returned by Recurrence.Next if a MINUTELY or HOURLY rule
+// has no occurrence within maxEmptyPeriods periods.
+var ErrSearchLimit = errors.New("rrule search limit exceeded")
+
+// emptyPeriodLimit returns the number of consecutive periods without an
+// occurrence after which a rule with the given frequency is considered
+// exhausted. The calendar repeats every 400 years, so a date based rule not
+// matching within that cycle never matches.
+func emptyPeriodLimit(freq Frequency) int {
+	switch freq {
+	case FrequencyYearly:
+		return 400 + 1
+	case FrequencyMonthly:
+		return 400*12 + 1
+	case FrequencyWeekly:
+		return 400*53 + 2
+	case FrequencyDaily:
+		return 146097 + 1
+	default:
+		return maxEmptyPeriods
+	}
+}
+
+// Next computes the next n occurrences of the recurrence strictly after
+// after, without asking AWX. The occurrences are in the location of DTStart.
+// Fewer than n occurrences are returned if the recurrence ends before. If a
+// MINUTELY or HOURLY rule gives up searching, the occurrences found so far
+// are returned with an error matching ErrSearchLimit.
+func (r *Recurrence) Next(after time.Time, n int) ([]time.Time, error) {
+	var rules, exrules []*ruleIterator
+	for _, rule := range r.RRules {
+		rules = append(rules, newRuleIterator(rule, r.DTStart, after))
+	}
+	for _, rule := range r.ExRules {
+		exrules = append(exrules, newRuleIterator(rule, r.DTStart, after))
+	}
+	var out []time.Time
+	for len(out) < n {
+		// Take the earliest pending occurrence of all rules.
+		var next *ruleIterator
+		for _, it := range rules {
+			if it.peek() && (next == nil || it.buf[0].Before(next.buf[0])) {
+				next = it
+			}
+		}
+		if next == nil {
+			break
+		}
+		t := next.buf[0]
+		for _, it := range rules {
+			if it.peek() && it.buf[0].Equal(t) {
+				it.buf = it.buf[1:]
+			}
+		}
+		if !t.After(after) || slices.ContainsFunc(exrules, func(it *ruleIterator) bool { return it.skipTo(t) }) {
+			continue
+		}
+		out = append(out, t)
+	}
+	for _, it := range slices.Concat(rules, exrules) {
+		if it.err != nil {
+			return out, it.err
+		}
+	}
+	return out, nil
+}
+
+// ruleIterator yields the occurrences of a rule in chronological order.
+type ruleIterator struct {
+	rule     *RRule
+	start    time.Time
+	interval int
+	limit    int
+	period   int
+	emitted  int
+	done     bool
+	err      error
+	buf      []time.Time
+}
+
+func newRuleIterator(rule *RRule, start, after time.Time) *ruleIterator {
+	it := &ruleIterator{
+		rule:     rule,
+		start:    start,
+		interval: max(rule.Interval, 1),
+		limit:    emptyPeriodLimit(rule.Freq),
+	}
+	// Periods of fixed length can be skipped up to after, unless COUNT
+	// requires counting the occurrences from the start.
+	if rule.Count == 0 && after.After(start) {
+		var unit time.Duration
+		switch rule.Freq {
+		case FrequencyMinutely:
+			unit = time.Minute
+		case FrequencyHourly:
+			unit = time.Hour
+		}
+		if unit > 0 {
+			it.period = int(after.Sub(start)/(unit*time.Duration(it.interval))) - 1
+			it.period = max(it.period, 0)
+		}
+	}
+	return it
+}
+
+// peek fills the buffer with the occurrences of the next non-empty period
+// and reports whether an occurrence is pending.
+func (it *ruleIterator) peek() bool {
+	for empty := 0; len(it.buf) == 0 && !it.done; empty++ {
+		if empty > it.limit {
+			it.done = true
+			if it.limit == maxEmptyPeriods {
+				it.err = fmt.Errorf("%w: no occurrence of %s within %d periods", ErrSearchLimit, it.rule, it.limit)
+			}
+			break
+		}
+		for _, t := range it.expand(it.period) {
+			if t.Before(it.start) {
+				continue
+			}
+			if !it.rule.Until.IsZero() && t.After(it.rule.Until) ||
+				it.rule.Count > 0 && it.emitted >= it.rule.Count {
+				it.done = true
+				break
+			}
+			it.emitted++
+			it.buf = append(it.buf, t)
+		}
+		it.period = it.nextPeriod()
+	}
+	return len(it.buf) > 0
+}
+
+// nextPeriod returns the period to expand after the current one. MINUTELY
+// and HOURLY rules skip the periods of the rest of the day if the date does
+// not match, and MINUTELY rules the rest of the hour if the hour does not.
+func (it *ruleIterator) nextPeriod() int {
+	next := it.period + 1
+	r := it.rule
+	if r.Freq != FrequencyMinutely && r.Freq != FrequencyHourly {
+		return next
+	}
+	slot := it.slot(it.period)
+	var end time.Time
+	switch {
+	case !r.matchDate(slot):
+		end = time.Date(slot.Year(), slot.Month(), slot.Day()+1, 0, 0, 0, 0, slot.Location())
+	case len(r.ByHour) > 0 && !slices.Contains(r.ByHour, slot.Hour()):
+		end = time.Date(slot.Year(), slot.Month(), slot.Day(), slot.Hour()+1, 0, 0, 0, slot.Location())
+	default:
+		return next
+	}
+	length := it.unit() * time.Duration(it.interval)
+	return max(next, it.period+int((end.Sub(slot)+length-1)/length))
+}
+
+// unit returns the length of a MINUTELY or HOURLY period before applying
+// the interval.
+func (it *ruleIterator) unit() time.Duration {
+	if it.rule.Freq == FrequencyMinutely {
+		return time.Minute
+	}
+	return time.Hour
+}
+
+// slot returns the start of the given period of a MINUTELY or HOURLY rule.
+func (it *ruleIterator) slot(period int) time.Time {
+	s := it.start
+	slot := time.Date(s.Year(), s.Month(), s.Day(), s.Hour(), 0, 0, 0, s.Location())
+	if it.rule.Freq == FrequencyMinutely {
+		slot = slot.Add(time.Duration(s.Minute()) * time.Minute)
+	}
+	return slot.Add(time.Duration(period*it.interval) * it.unit())
+}
+
+// skipTo drops the occurrences before t and reports whether t is an
+// occurrence of the rule.
+func (it *ruleIterator) skipTo(t time.Time) bool {
+	for it.peek() && it.buf[0].Before(t) {
+		it.buf = it.buf[1:]
+	}
+	return it.peek() && it.buf[0].Equal(t)
+}
+
+// expand returns the sorted occurrences of the given period of the rule.
+func (it *ruleIterator) expand(period int) []time.Time {
+	r, s := it.rule, it.start
+	loc := s.Location()
+	step := period * it.interval
+	var days []time.Time
+	switch r.Freq {
+	case FrequencyYearly:
+		year := s.Year() + step
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 && len(r.ByMonth) == 0:
+			days = appendDate(days, year, s.Month(), s.Day(), loc)
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			for _, m := range r.ByMonth {
+				days = appendDate(days, year, time.Month(m), s.Day(), loc)
+			}
+		default:
+			for d := time.Date(year, 1, 1, 0, 0, 0, 0, loc); d.Year() == year; d = d.AddDate(0, 0, 1) {
+				if r.matchDate(d) {
+					days = append(days, d)
+				}
+			}
+		}
+	case FrequencyMonthly:
+		first := time.Date(s.Year(), s.Month()+time.Month(step), 1, 0, 0, 0, 0, loc)
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			days = appendDate(days, first.Year(), first.Month(), s.Day(), loc)
+			break
+		}
+		for d := first; d.Month() == first.Month(); d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+	case FrequencyWeekly:
+		weekStart := time.Monday
+		if r.WeekStart != nil {
+			weekStart = *r.WeekStart
+		}
+		day := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0, loc)
+		first := day.AddDate(0, 0, -int((7+day.Weekday()-weekStart)%7)+7*step)
+		for i := range 7 {
+			d := first.AddDate(0, 0, i)
+			if len(r.ByDay) > 0 || d.Weekday() == s.Weekday() {
+				days = append(days, d)
+			}
+		}
+	case FrequencyDaily:
+		days = append(days, time.Date(s.Year(), s.Month(), s.Day()+step, 0, 0, 0, 0, loc))
+	case FrequencyHourly, FrequencyMinutely:
+		slot := it.slot(period)
+		if !r.matchDate(slot) || len(r.ByHour) > 0 && !slices.Contains(r.ByHour, slot.Hour()) {
+			return nil
+		}
+		var out []time.Time
+		if r.Freq == FrequencyMinutely {
+			if len(r.ByMinute) == 0 || slices.Contains(r.ByMinute, slot.Minute()) {
+				out = append(out, slot.Add(time.Duration(s.Second())*time.Second))
+			}
+		} else {
+			for _, m := range valuesOr(r.ByMinute, s.Minute()) {
+				out = append(out, slot.Add(time.Duration(m)*time.Minute+time.Duration(s.Second())*time.Second))
+			}
+		}
+		return r.applySetPos(out)
+	default:
+		return nil
+	}
+
+	var out []time.Time
+	for _, d := range days {
+		if !r.matchDate(d) {
+			continue
+		}
+		for _, h := range valuesOr(r.ByHour, s.Hour()) {
+			for _, m := range valuesOr(r.ByMinute, s.Minute()) {
+				out = append(out, time.Date(d.Year(), d.Month(), d.Day(), h, m, s.Second(), 0, loc))
+			}
+		}
+	}
+	return r.applySetPos(out)
+}
+
+// matchDate reports whether the date of d matches the BYMONTH, BYMONTHDAY
+// and BYDAY parts of the rule.
+func (r *RRule) matchDate(d time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(d.Month())) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !slices.Contains(r.ByDay, d.Weekday()) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		daysInMonth := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if !slices.Contains(r.ByMonthDay, d.Day()) && !slices.Contains(r.ByMonthDay, d.Day()-daysInMonth-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// applySetPos sorts the occurrences of a period and selects the BYSETPOS
+// positions among them.
+func (r *RRule) applySetPos(set []time.Time) []time.Time {
+	slices.SortFunc(set, func(a, b time.Time) int { return a.Compare(b) })
+	set = slices.CompactFunc(set, time.Time.Equal)
+	if len(r.BySetPos) == 0 {
+		return set
+	}
+	var out []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(set) + pos
+		}
+		if i >= 0 && i < len(set) {
+			out = append(out, set[i])
+		}
+	}
+	slices.SortFunc(out, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(out, time.Time.Equal)
+}
+
+// appendDate appends the given date, unless it does not exist, e.g. the 31st
+// of a month with 30 days.
+func appendDate(days []time.Time, year int, month time.Month, day int, loc *time.Location) []time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if d.Day() != day {
+		return days
+	}
+	return append(days, d)
+}
+
+func valuesOr(values []int, fallback int) []int {
+	if len(values) == 0 {
+		return []int{fallback}
+	}
+	return slices.Sorted(slices.Values(values))
+}
@@ -0,0 +1,182 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceRoundTrip(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	r := NewRecurrence(time.Date(2025, 1, 1, 3, 0, 0, 0, berlin), &RRule{
+		Freq:     FrequencyWeekly,
+		Interval: 2,
+		ByDay:    []time.Weekday{time.Monday, time.Thursday},
+		ByHour:   []int{3},
+		Count:    10,
+	})
+	r.ExRules = []*RRule{{Freq: FrequencyMonthly, Interval: 1, ByMonthDay: []int{1}}}
+	assert.NoError(t, r.Validate())
+
+	s := r.String()
+	assert.Equal(t, "DTSTART;TZID=Europe/Berlin:20250101T030000 RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;BYHOUR=3;COUNT=10 EXRULE:FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=1", s)
+	assert.Equal(t, "every 2 weeks on Monday and Thursday at 03:00, 10 times; except every month on the 1st day of the month, starting 2025-01-01 03:00 CET (Europe/Berlin)", r.Describe())
+
+	parsed, err := ParseRecurrence(s)
+	assert.NoError(t, err)
+	assert.Equal(t, s, parsed.String())
+	assert.True(t, r.DTStart.Equal(parsed.DTStart))
+
+	parsed, err = ParseRecurrence("DTSTART:20250301T120000Z\nRRULE:FREQ=DAILY;INTERVAL=1;UNTIL=20250401T000000Z")
+	assert.NoError(t, err)
+	assert.NoError(t, parsed.Validate())
+	assert.Equal(t, "every day, until 2025-04-01 00:00 UTC, starting 2025-03-01 12:00 UTC", parsed.Describe())
+
+	in := &ScheduleInput{}
+	assert.NoError(t, in.SetRecurrence(parsed))
+	assert.Equal(t, "DTSTART:20250301T120000Z RRULE:FREQ=DAILY;INTERVAL=1;UNTIL=20250401T000000Z", in.RRULE)
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	for _, s := range []string{
+		"DTSTART:20250101T000000 RRULE:FREQ=DAILY",
+		"DTSTART:20250101T000000Z DTSTART:20250102T000000Z RRULE:FREQ=DAILY",
+		"DTSTART:20250101T000000Z RRULE:FREQ=WEEKLY;BYDAY=1MO",
+		"DTSTART:20250101T000000Z RRULE:FREQ=YEARLY;BYYEARDAY=1",
+		"DTSTART;TZID=Mars/Olympus:20250101T000000 RRULE:FREQ=DAILY",
+	} {
+		_, err := ParseRecurrence(s)
+		assert.Error(t, err, s)
+	}
+
+	for s, msg := range map[string]string{
+		"DTSTART:20250101T000000Z":                                                          "one or more rule required in rrule",
+		"RRULE:FREQ=DAILY":                                                                  "valid DTSTART required in rrule",
+		"DTSTART:20250101T000000Z RRULE:INTERVAL=1":                                         "FREQ required in rrule",
+		"DTSTART:20250101T000000Z RRULE:FREQ=SECONDLY":                                      "SECONDLY is not supported",
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY;COUNT=1000":                              "COUNT > 999 is unsupported",
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY;BYHOUR=24":                               "BYHOUR value 24 out of range",
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY":                                         "INTERVAL required in rrule",
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY;INTERVAL=0":                              "INTERVAL required in rrule",
+		"DTSTART:20250101T000000Z EXRULE:FREQ=DAILY;INTERVAL=1 RRULE:FREQ=DAILY;INTERVAL=1": "",
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY;COUNT=2;UNTIL=20250201T000000Z":          "RRULE may not contain both COUNT and UNTIL",
+	} {
+		r, err := ParseRecurrence(s)
+		assert.NoError(t, err, s)
+		if msg == "" {
+			assert.NoError(t, r.Validate(), s)
+		} else {
+			assert.ErrorContains(t, r.Validate(), msg, s)
+		}
+	}
+	// Validate checks the rule as String renders it.
+	wkst := time.Weekday(7)
+	r := NewRecurrence(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), &RRule{Freq: FrequencyWeekly, WeekStart: &wkst})
+	assert.Equal(t, "DTSTART:20250101T000000Z RRULE:FREQ=WEEKLY;INTERVAL=0;WKST=7", r.String())
+	assert.ErrorContains(t, r.Validate(), "INTERVAL required in rrule")
+	assert.ErrorContains(t, r.Validate(), "WKST value 7 out of range")
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	format := func(times []time.Time) []string {
+		var out []string
+		for _, t := range times {
+			out = append(out, t.Format(time.RFC3339))
+		}
+		return out
+	}
+	after := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	for s, expected := range map[string][]string{
+		// The daily run keeps its local time across the DST change.
+		"DTSTART;TZID=Europe/Berlin:20250101T030000 RRULE:FREQ=DAILY": {
+			"2025-03-01T03:00:00+01:00", "2025-03-02T03:00:00+01:00",
+		},
+		"DTSTART;TZID=Europe/Berlin:20250328T030000 RRULE:FREQ=DAILY;COUNT=3": {
+			"2025-03-28T03:00:00+01:00", "2025-03-29T03:00:00+01:00", "2025-03-30T03:00:00+02:00",
+		},
+		"DTSTART:20250101T000000Z RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;BYHOUR=6,18": {
+			"2025-03-10T06:00:00Z", "2025-03-10T18:00:00Z", "2025-03-14T06:00:00Z",
+		},
+		"DTSTART:20250101T120000Z RRULE:FREQ=MONTHLY;BYMONTHDAY=-1": {
+			"2025-03-31T12:00:00Z", "2025-04-30T12:00:00Z",
+		},
+		"DTSTART:20250101T120000Z RRULE:FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1": {
+			"2025-03-03T12:00:00Z", "2025-04-07T12:00:00Z",
+		},
+		"DTSTART:20250131T000000Z RRULE:FREQ=MONTHLY": {
+			"2025-03-31T00:00:00Z", "2025-05-31T00:00:00Z",
+		},
+		"DTSTART:20240229T000000Z RRULE:FREQ=YEARLY;UNTIL=20300101T000000Z": {
+			"2028-02-29T00:00:00Z",
+		},
+		"DTSTART:20200101T000015Z RRULE:FREQ=MINUTELY;INTERVAL=15": {
+			"2025-03-01T00:00:15Z", "2025-03-01T00:15:15Z",
+		},
+		"DTSTART:20250101T000000Z RRULE:FREQ=HOURLY;BYHOUR=9,17 EXRULE:FREQ=DAILY;BYDAY=SA,SU;BYHOUR=9,17": {
+			"2025-03-03T09:00:00Z", "2025-03-03T17:00:00Z",
+		},
+		"DTSTART:20250101T000000Z RRULE:FREQ=DAILY;BYHOUR=1 RRULE:FREQ=DAILY;BYHOUR=1,2;BYMONTH=3": {
+			"2025-03-01T01:00:00Z", "2025-03-01T02:00:00Z", "2025-03-02T01:00:00Z",
+		},
+		// Sparse MINUTELY and HOURLY rules skip the days which do not match.
+		"DTSTART:20250101T000000Z RRULE:FREQ=MINUTELY;BYMONTH=2;BYMONTHDAY=29;BYHOUR=12;BYMINUTE=30": {
+			"2028-02-29T12:30:00Z",
+		},
+		"DTSTART:20250101T000000Z RRULE:FREQ=HOURLY;INTERVAL=5;BYMONTH=2;BYMONTHDAY=29": {
+			"2028-02-29T04:00:00Z", "2028-02-29T09:00:00Z",
+		},
+		"DTSTART:20250101T000000Z RRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30": nil,
+	} {
+		r, err := ParseRecurrence(s)
+		assert.NoError(t, err, s)
+		n := len(expected)
+		if n == 0 {
+			n = 1
+		}
+		if r.RRules[0].Count > 0 {
+			next, err := r.Next(time.Time{}, 5)
+			assert.NoError(t, err, s)
+			assert.Equal(t, expected, format(next), s)
+			continue
+		}
+		next, err := r.Next(after, n)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, format(next), s)
+	}
+
+	r, err := ParseRecurrence("DTSTART;TZID=Europe/Berlin:20250101T030000 RRULE:FREQ=DAILY")
+	assert.NoError(t, err)
+	next, err := r.Next(after, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, berlin, next[0].Location())
+
+	// A MINUTELY rule which never matches gives up with an error instead of
+	// reporting that there is no further occurrence.
+	r, err = ParseRecurrence("DTSTART:20250101T000000Z RRULE:FREQ=MINUTELY;INTERVAL=1;BYMONTH=2;BYMONTHDAY=30")
+	assert.NoError(t, err)
+	next, err = r.Next(after, 1)
+	assert.ErrorIs(t, err, ErrSearchLimit)
+	assert.Empty(t, next)
+}
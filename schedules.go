@@ -19,19 +19,58 @@
 
 package awx
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
 // Schedule represents the output of the GetSchedule method.
 type Schedule struct {
-	ID                 int    `json:"id"`
-	RRULE              string `json:"rrule"`
-	Type               string `json:"type"`
-	URL                string `json:"url"`
-	Created            string `json:"created"`
-	Modified           string `json:"modified"`
-	ExtraData          string `json:"extra_data"`
-	Name               string `json:"name"`
-	Description        string `json:"description"`
-	UnifiedJobTemplate int    `json:"unified_job_template"`
-	Inventory          int    `json:"inventory"`
+	ID                 int       `json:"id"`
+	RRULE              string    `json:"rrule"`
+	Type               string    `json:"type"`
+	URL                string    `json:"url"`
+	Created            string    `json:"created"`
+	Modified           string    `json:"modified"`
+	ExtraData          ExtraData `json:"extra_data,omitempty"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	Enabled            bool      `json:"enabled"`
+	// DTStart, DTEnd and NextRun are computed by AWX from the rrule. DTEnd
+	// and NextRun are zero if the schedule has no further occurrence.
+	DTStart  time.Time `json:"dtstart"`
+	DTEnd    time.Time `json:"dtend"`
+	NextRun  time.Time `json:"next_run"`
+	Timezone string    `json:"timezone"`
+	Until    string    `json:"until"`
+	ScheduleOverrides
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ScheduleOverrides represents the prompt on launch values a schedule
+// overrides in its unified job template. Nil fields are left out of requests,
+// so they keep their current value; a pointer to an empty string clears a
+// text override.
+type ScheduleOverrides struct {
+	Inventory            *int    `json:"inventory,omitempty"`
+	Limit                *string `json:"limit,omitempty"`
+	SCMBranch            *string `json:"scm_branch,omitempty"`
+	JobType              *string `json:"job_type,omitempty"`
+	JobTags              *string `json:"job_tags,omitempty"`
+	SkipTags             *string `json:"skip_tags,omitempty"`
+	DiffMode             *bool   `json:"diff_mode,omitempty"`
+	Verbosity            *int    `json:"verbosity,omitempty"`
+	Forks                *int    `json:"forks,omitempty"`
+	JobSliceCount        *int    `json:"job_slice_count,omitempty"`
+	Timeout              *int    `json:"timeout,omitempty"`
+	ExecutionEnvironment *int    `json:"execution_environment,omitempty"`
 }
 
 // ScheduleList represents the output of the ListSchedule method.
@@ -42,6 +81,171 @@ type ScheduleList struct {
 
 // ListSchedulesInput represents the input of the ListSchedules method.
 type ListSchedulesInput struct {
-	ID   string `schema:"id,omitempty"`
-	Name string `schema:"name,omitempty"`
+	ID                 string `schema:"id,omitempty"`
+	Name               string `schema:"name,omitempty"`
+	UnifiedJobTemplate int    `schema:"unified_job_template,omitempty"`
+	Inventory          int    `schema:"inventory,omitempty"`
+	Enabled            *bool  `schema:"enabled,omitempty"`
+}
+
+// ScheduleInput represents the input of the CreateSchedule, UpdateSchedule
+// and PatchSchedule methods. When patching, only the set fields are changed.
+type ScheduleInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	RRULE       string `json:"rrule,omitempty"`
+	// UnifiedJobTemplate is only needed when creating a schedule through
+	// CreateSchedule.
+	UnifiedJobTemplate int       `json:"unified_job_template,omitempty"`
+	Enabled            *bool     `json:"enabled,omitempty"`
+	ExtraData          ExtraData `json:"extra_data,omitempty"`
+	ScheduleOverrides
+}
+
+const schedulesResource = "schedules"
+
+// GetSchedule retrieves the schedule with the given id.
+func GetSchedule(ctx context.Context, c Client, id int) (*Schedule, error) {
+	return getByID[Schedule](ctx, c, schedulesResource, id)
+}
+
+// ListSchedules retrieves all schedules matching input.
+func ListSchedules(ctx context.Context, c Client, input *ListSchedulesInput) ([]*Schedule, error) {
+	return listAll[Schedule](ctx, c, ObjectKey{Resource: schedulesResource}, input)
+}
+
+// ListTemplateSchedules retrieves the schedules of the unified job template
+// at template, e.g. ObjectKey{Resource: "job_templates", ResourceID: "1"}.
+func ListTemplateSchedules(ctx context.Context, c Client, template ObjectKey) ([]*Schedule, error) {
+	template.Action = schedulesResource
+	return listAll[Schedule](ctx, c, template, nil)
+}
+
+// CreateSchedule creates a schedule for the unified job template given by
+// input.UnifiedJobTemplate.
+func CreateSchedule(ctx context.Context, c Client, input *ScheduleInput) (*Schedule, error) {
+	schedule := &Schedule{}
+	err := post(ctx, c, ObjectKey{Resource: schedulesResource}, input, schedule, []int{http.StatusCreated})
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// CreateTemplateSchedule creates a schedule below the unified job template at
+// template, e.g. ObjectKey{Resource: "workflow_job_templates", ResourceID: "1"}.
+func CreateTemplateSchedule(ctx context.Context, c Client, template ObjectKey, input *ScheduleInput) (*Schedule, error) {
+	template.Action = schedulesResource
+	schedule := &Schedule{}
+	if err := post(ctx, c, template, input, schedule, []int{http.StatusCreated}); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// CreateJobTemplateSchedule creates a schedule for the job template with the given id.
+func CreateJobTemplateSchedule(ctx context.Context, c Client, templateID int, input *ScheduleInput) (*Schedule, error) {
+	return CreateTemplateSchedule(ctx, c, ObjectKey{Resource: "job_templates", ResourceID: strconv.Itoa(templateID)}, input)
+}
+
+// UpdateSchedule replaces the schedule with the given id with input.
+func UpdateSchedule(ctx context.Context, c Client, id int, input *ScheduleInput) (*Schedule, error) {
+	schedule := &Schedule{}
+	if err := c.Update(ctx, idKey(schedulesResource, id), &exchange{in: input, out: schedule}, nil); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// PatchSchedule changes the fields set in input of the schedule with the given id.
+// c must implement Patcher.
+func PatchSchedule(ctx context.Context, c Client, id int, input *ScheduleInput) (*Schedule, error) {
+	p, ok := c.(Patcher)
+	if !ok {
+		return nil, fmt.Errorf("patching schedule %d: %w", id, errors.ErrUnsupported)
+	}
+	schedule := &Schedule{}
+	if err := p.Patch(ctx, idKey(schedulesResource, id), &exchange{in: input, out: schedule}, nil); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// EnableSchedule enables the schedule with the given id.
+func EnableSchedule(ctx context.Context, c Client, id int) error {
+	enabled := true
+	_, err := PatchSchedule(ctx, c, id, &ScheduleInput{Enabled: &enabled})
+	return err
+}
+
+// DisableSchedule disables the schedule with the given id.
+func DisableSchedule(ctx context.Context, c Client, id int) error {
+	enabled := false
+	_, err := PatchSchedule(ctx, c, id, &ScheduleInput{Enabled: &enabled})
+	return err
+}
+
+// DeleteSchedule deletes the schedule with the given id.
+func DeleteSchedule(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(schedulesResource, id), nil)
+}
+
+// SchedulePreview represents the output of the PreviewSchedule method.
+type SchedulePreview struct {
+	// Local holds the occurrences in the time zone of the rrule.
+	Local []time.Time `json:"local"`
+	UTC   []time.Time `json:"utc"`
+}
+
+// PreviewSchedule asks AWX for the next occurrences of rrule, without
+// creating a schedule. Use Recurrence.Next to compute them offline.
+func PreviewSchedule(ctx context.Context, c Client, rrule string) (*SchedulePreview, error) {
+	preview := &SchedulePreview{}
+	input := map[string]string{"rrule": rrule}
+	err := post(ctx, c, ObjectKey{Resource: schedulesResource, Action: "preview"}, input, preview, []int{http.StatusOK})
+	if err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// ScheduleZoneInfo represents the output of the GetScheduleZoneInfo method.
+type ScheduleZoneInfo struct {
+	// Zones holds the names of the time zones accepted as TZID.
+	Zones []string
+	// Links maps alias zone names to their canonical names.
+	Links map[string]string
+}
+
+// UnmarshalJSON decodes the zone info of current AWX versions, an object
+// with zones and links, as well as the plain zone list of older versions.
+func (z *ScheduleZoneInfo) UnmarshalJSON(data []byte) error {
+	var list []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &list); err == nil {
+		z.Zones = nil
+		for _, zone := range list {
+			z.Zones = append(z.Zones, zone.Name)
+		}
+		return nil
+	}
+	var info struct {
+		Zones []string          `json:"zones"`
+		Links map[string]string `json:"links"`
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return err
+	}
+	z.Zones, z.Links = info.Zones, info.Links
+	return nil
+}
+
+// GetScheduleZoneInfo retrieves the time zones AWX accepts in rrules.
+func GetScheduleZoneInfo(ctx context.Context, c Client) (*ScheduleZoneInfo, error) {
+	info := &ScheduleZoneInfo{}
+	if err := c.Get(ctx, ObjectKey{Resource: schedulesResource, Action: "zoneinfo"}, info, nil); err != nil {
+		return nil, err
+	}
+	return info, nil
 }
@@ -21,9 +21,11 @@ package awx
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -37,7 +39,7 @@ func TestClient_CreateSchedule(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
-		assert.Equal(t, "/schedules", r.URL.Path)
+		assert.Equal(t, "/schedules/", r.URL.Path)
 
 		var received Schedule
 		err := json.NewDecoder(r.Body).Decode(&received)
@@ -77,7 +79,7 @@ func TestClient_CreateSchedule(t *testing.T) {
 func TestClient_DeleteSchedule(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodDelete, r.Method)
-		assert.Equal(t, "/schedules/1", r.URL.Path)
+		assert.Equal(t, "/schedules/1/", r.URL.Path)
 		w.WriteHeader(http.StatusNoContent)
 	})
 
@@ -98,3 +100,123 @@ func TestClient_DeleteSchedule(t *testing.T) {
 	}, nil)
 	assert.NoError(t, err)
 }
+
+func TestCreateAndDisableJobTemplateSchedule(t *testing.T) {
+	var patched map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /job_templates/1/schedules/", func(w http.ResponseWriter, r *http.Request) {
+		received := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, 3.0, received["inventory"])
+		assert.Equal(t, map[string]any{"region": "eu-de-1"}, received["extra_data"])
+		assert.Equal(t, false, received["diff_mode"])
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{
+			"id": 9,
+			"name": "nightly",
+			"rrule": "DTSTART:20250101T000000Z RRULE:FREQ=DAILY;INTERVAL=1",
+			"unified_job_template": 1,
+			"enabled": true,
+			"inventory": 3,
+			"extra_data": {"region": "eu-de-1"}
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("PATCH /schedules/9/", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&patched)
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write([]byte(`{"id": 9, "name": "nightly", "enabled": false}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	diffMode, inventory := false, 3
+	schedule, err := CreateJobTemplateSchedule(context.Background(), client, 1, &ScheduleInput{
+		Name:      "nightly",
+		RRULE:     "DTSTART:20250101T000000Z RRULE:FREQ=DAILY;INTERVAL=1",
+		ExtraData: ExtraData{"region": "eu-de-1"},
+		ScheduleOverrides: ScheduleOverrides{
+			Inventory: &inventory,
+			DiffMode:  &diffMode,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, schedule.ID)
+	assert.Equal(t, &inventory, schedule.Inventory)
+	assert.Equal(t, ExtraData{"region": "eu-de-1"}, schedule.ExtraData)
+
+	err = DisableSchedule(context.Background(), client, schedule.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"enabled": false}, patched)
+
+	// An empty override is sent, so that it clears the one of the schedule.
+	limit := ""
+	patched = nil
+	_, err = PatchSchedule(context.Background(), client, schedule.ID, &ScheduleInput{
+		ScheduleOverrides: ScheduleOverrides{Limit: &limit},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"limit": ""}, patched)
+
+	// Clients which do not implement Patcher cannot patch schedules.
+	err = DisableSchedule(context.Background(), struct{ Client }{client}, schedule.ID)
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	const rrule = "DTSTART;TZID=Europe/Berlin:20250101T030000 RRULE:FREQ=WEEKLY;BYDAY=MO"
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /schedules/preview/", func(w http.ResponseWriter, r *http.Request) {
+		received := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"rrule": rrule}, received)
+		_, err = w.Write([]byte(`{
+			"local": ["2025-03-24T03:00:00+01:00", "2025-03-31T03:00:00+02:00"],
+			"utc": ["2025-03-24T02:00:00Z", "2025-03-31T01:00:00Z"]
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /schedules/zoneinfo/", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"zones": ["Europe/Berlin", "UTC"], "links": {"Etc/UTC": "UTC"}}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	preview, err := PreviewSchedule(context.Background(), client, rrule)
+	assert.NoError(t, err)
+	assert.Len(t, preview.UTC, 2)
+
+	// The offline computation matches the preview of the server.
+	r, err := ParseRecurrence(rrule)
+	assert.NoError(t, err)
+	next, err := r.Next(time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), 2)
+	assert.NoError(t, err)
+	for i := range next {
+		assert.True(t, preview.UTC[i].Equal(next[i]))
+		assert.True(t, preview.Local[i].Equal(next[i]))
+	}
+
+	info, err := GetScheduleZoneInfo(context.Background(), client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Europe/Berlin", "UTC"}, info.Zones)
+	assert.Equal(t, map[string]string{"Etc/UTC": "UTC"}, info.Links)
+
+	err = json.Unmarshal([]byte(`[{"name": "America/New_York"}]`), info)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"America/New_York"}, info.Zones)
+}
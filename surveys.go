@@ -0,0 +1,283 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SurveyQuestionType represents the type of a survey question.
+type SurveyQuestionType string
+
+// Survey question types supported by AWX.
+const (
+	SurveyQuestionText           SurveyQuestionType = "text"
+	SurveyQuestionTextarea       SurveyQuestionType = "textarea"
+	SurveyQuestionPassword       SurveyQuestionType = "password"
+	SurveyQuestionInteger        SurveyQuestionType = "integer"
+	SurveyQuestionFloat          SurveyQuestionType = "float"
+	SurveyQuestionMultipleChoice SurveyQuestionType = "multiplechoice"
+	SurveyQuestionMultiSelect    SurveyQuestionType = "multiselect"
+)
+
+// SurveySpec represents the survey of a job template or workflow job template.
+type SurveySpec struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Spec        []*SurveyQuestion `json:"spec"`
+}
+
+// SurveyQuestion represents a single question of a survey.
+type SurveyQuestion struct {
+	QuestionName        string             `json:"question_name"`
+	QuestionDescription string             `json:"question_description,omitempty"`
+	Variable            string             `json:"variable"`
+	Type                SurveyQuestionType `json:"type"`
+	Required            bool               `json:"required"`
+	Min                 *float64           `json:"min,omitempty"`
+	Max                 *float64           `json:"max,omitempty"`
+	Choices             SurveyChoices      `json:"choices,omitempty"`
+	Default             any                `json:"default,omitempty"`
+}
+
+// SurveyChoices represents the choices of a multiplechoice or multiselect
+// question. Older AWX versions encode them as a newline separated string,
+// newer versions as a list; both are accepted when decoding.
+type SurveyChoices []string
+
+// UnmarshalJSON decodes choices given either as a string or as a list.
+func (c *SurveyChoices) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*c = list
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid survey choices: %s", string(data))
+	}
+	*c = nil
+	for _, choice := range strings.Split(s, "\n") {
+		if choice = strings.TrimSpace(choice); choice != "" {
+			*c = append(*c, choice)
+		}
+	}
+	return nil
+}
+
+// jobTemplateKey returns the object key of the job template with the given id.
+func jobTemplateKey(templateID int) ObjectKey {
+	return ObjectKey{Resource: "job_templates", ResourceID: strconv.Itoa(templateID)}
+}
+
+// GetSurveySpec retrieves the survey of the given job template.
+func GetSurveySpec(ctx context.Context, c Client, templateID int) (*SurveySpec, error) {
+	return GetTemplateSurveySpec(ctx, c, jobTemplateKey(templateID))
+}
+
+// SetSurveySpec replaces the survey of the given job template.
+func SetSurveySpec(ctx context.Context, c Client, templateID int, spec *SurveySpec) error {
+	return SetTemplateSurveySpec(ctx, c, jobTemplateKey(templateID), spec)
+}
+
+// DeleteSurveySpec removes the survey of the given job template.
+func DeleteSurveySpec(ctx context.Context, c Client, templateID int) error {
+	return DeleteTemplateSurveySpec(ctx, c, jobTemplateKey(templateID))
+}
+
+// GetTemplateSurveySpec retrieves the survey of the template at template,
+// e.g. ObjectKey{Resource: "workflow_job_templates", ResourceID: "1"}.
+func GetTemplateSurveySpec(ctx context.Context, c Client, template ObjectKey) (*SurveySpec, error) {
+	template.Action = "survey_spec"
+	spec := &SurveySpec{}
+	if err := c.Get(ctx, template, spec, nil); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// SetTemplateSurveySpec replaces the survey of the template at template.
+func SetTemplateSurveySpec(ctx context.Context, c Client, template ObjectKey, spec *SurveySpec) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	template.Action = "survey_spec"
+	return c.Create(ctx, template, spec, []int{http.StatusOK, http.StatusCreated})
+}
+
+// DeleteTemplateSurveySpec removes the survey of the template at template.
+func DeleteTemplateSurveySpec(ctx context.Context, c Client, template ObjectKey) error {
+	template.Action = "survey_spec"
+	return c.Delete(ctx, template, []int{http.StatusOK, http.StatusNoContent})
+}
+
+// ValidateSurveyExtraVars retrieves the survey of the given job template and
+// validates extraVars against it, so that bad input is caught before launching.
+func ValidateSurveyExtraVars(ctx context.Context, c Client, templateID int, extraVars map[string]any) error {
+	spec, err := GetSurveySpec(ctx, c, templateID)
+	if err != nil {
+		return err
+	}
+	return spec.ValidateExtraVars(extraVars)
+}
+
+// Validate checks that the survey is well formed.
+func (s *SurveySpec) Validate() error {
+	var errs []error
+	seen := map[string]bool{}
+	for i, q := range s.Spec {
+		if q.Variable == "" {
+			errs = append(errs, fmt.Errorf("survey question %d: variable is mandatory", i))
+			continue
+		}
+		if seen[q.Variable] {
+			errs = append(errs, fmt.Errorf("survey question %q: duplicate variable", q.Variable))
+		}
+		seen[q.Variable] = true
+		switch q.Type {
+		case SurveyQuestionText, SurveyQuestionTextarea, SurveyQuestionPassword,
+			SurveyQuestionInteger, SurveyQuestionFloat:
+		case SurveyQuestionMultipleChoice, SurveyQuestionMultiSelect:
+			if len(q.Choices) == 0 {
+				errs = append(errs, fmt.Errorf("survey question %q: choices are mandatory for type %s", q.Variable, q.Type))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("survey question %q: unknown type %q", q.Variable, q.Type))
+		}
+		if q.Min != nil && q.Max != nil && *q.Min > *q.Max {
+			errs = append(errs, fmt.Errorf("survey question %q: min is greater than max", q.Variable))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateExtraVars checks extraVars against the survey the same way AWX does
+// on launch. All violations are returned joined into a single error.
+func (s *SurveySpec) ValidateExtraVars(extraVars map[string]any) error {
+	var errs []error
+	for _, q := range s.Spec {
+		value, ok := extraVars[q.Variable]
+		if !ok || value == nil {
+			if q.Required && q.Default == nil {
+				errs = append(errs, fmt.Errorf("survey variable %q: value is required", q.Variable))
+			}
+			continue
+		}
+		if err := q.validate(value); err != nil {
+			errs = append(errs, fmt.Errorf("survey variable %q: %w", q.Variable, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (q *SurveyQuestion) validate(value any) error {
+	switch q.Type {
+	case SurveyQuestionText, SurveyQuestionTextarea, SurveyQuestionPassword:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+		if q.Required && s == "" {
+			return errors.New("value is required")
+		}
+		return q.checkRange(float64(utf8.RuneCountInString(s)), "length")
+	case SurveyQuestionInteger:
+		n, ok := toFloat(value)
+		if !ok || n != float64(int64(n)) {
+			return fmt.Errorf("expected an integer, got %v", value)
+		}
+		return q.checkRange(n, "value")
+	case SurveyQuestionFloat:
+		n, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("expected a number, got %v", value)
+		}
+		return q.checkRange(n, "value")
+	case SurveyQuestionMultipleChoice:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+		if !slices.Contains(q.Choices, s) {
+			return fmt.Errorf("%q is not a valid choice", s)
+		}
+	case SurveyQuestionMultiSelect:
+		var values []string
+		switch v := value.(type) {
+		case []string:
+			values = v
+		case []any:
+			for _, e := range v {
+				s, ok := e.(string)
+				if !ok {
+					return fmt.Errorf("expected a list of strings, got element %T", e)
+				}
+				values = append(values, s)
+			}
+		default:
+			return fmt.Errorf("expected a list of strings, got %T", value)
+		}
+		if q.Required && len(values) == 0 {
+			return errors.New("at least one choice is required")
+		}
+		for _, s := range values {
+			if !slices.Contains(q.Choices, s) {
+				return fmt.Errorf("%q is not a valid choice", s)
+			}
+		}
+	}
+	return nil
+}
+
+func (q *SurveyQuestion) checkRange(n float64, what string) error {
+	if q.Min != nil && n < *q.Min {
+		return fmt.Errorf("%s %v is lower than minimum %v", what, n, *q.Min)
+	}
+	if q.Max != nil && n > *q.Max {
+		return fmt.Errorf("%s %v is greater than maximum %v", what, n, *q.Max)
+	}
+	return nil
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
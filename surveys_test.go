@@ -0,0 +1,129 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSurveySpec(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /job_templates/1/survey_spec/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{
+			"name": "deploy",
+			"description": "",
+			"spec": [
+				{
+					"question_name": "Region",
+					"variable": "region",
+					"type": "multiplechoice",
+					"required": true,
+					"choices": "eu-de-1\neu-nl-1"
+				},
+				{
+					"question_name": "Replicas",
+					"variable": "replicas",
+					"type": "integer",
+					"required": false,
+					"min": 1,
+					"max": 5,
+					"default": 3
+				}
+			]
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /job_templates/1/survey_spec/", func(w http.ResponseWriter, r *http.Request) {
+		var received SurveySpec
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(received.Spec))
+		w.WriteHeader(http.StatusOK)
+	})
+	var workflowCalls []string
+	mux.HandleFunc("/workflow_job_templates/2/survey_spec/", func(w http.ResponseWriter, r *http.Request) {
+		workflowCalls = append(workflowCalls, r.Method)
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	spec, err := GetSurveySpec(context.Background(), client, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(spec.Spec))
+	assert.Equal(t, SurveyChoices{"eu-de-1", "eu-nl-1"}, spec.Spec[0].Choices)
+	assert.Equal(t, 5.0, *spec.Spec[1].Max)
+
+	err = SetSurveySpec(context.Background(), client, 1, spec)
+	assert.NoError(t, err)
+
+	workflow := ObjectKey{Resource: "workflow_job_templates", ResourceID: "2"}
+	assert.NoError(t, SetTemplateSurveySpec(context.Background(), client, workflow, spec))
+	assert.NoError(t, DeleteTemplateSurveySpec(context.Background(), client, workflow))
+	assert.Equal(t, []string{http.MethodPost, http.MethodDelete}, workflowCalls)
+}
+
+func TestSurveySpecValidateExtraVars(t *testing.T) {
+	minimum, maximum := 1.0, 5.0
+	spec := SurveySpec{
+		Spec: []*SurveyQuestion{
+			{Variable: "region", Type: SurveyQuestionMultipleChoice, Required: true, Choices: SurveyChoices{"eu-de-1", "eu-nl-1"}},
+			{Variable: "replicas", Type: SurveyQuestionInteger, Min: &minimum, Max: &maximum},
+			{Variable: "tags", Type: SurveyQuestionMultiSelect, Choices: SurveyChoices{"a", "b"}},
+			{Variable: "name", Type: SurveyQuestionText, Required: true, Max: &maximum},
+		},
+	}
+
+	err := spec.ValidateExtraVars(map[string]any{
+		"region":   "eu-de-1",
+		"replicas": 3,
+		"tags":     []any{"a", "b"},
+		"name":     "web",
+	})
+	assert.NoError(t, err)
+
+	err = spec.ValidateExtraVars(map[string]any{
+		"region":   "us-east-1",
+		"replicas": 2.5,
+		"tags":     []string{"c"},
+	})
+	assert.ErrorContains(t, err, `survey variable "region": "us-east-1" is not a valid choice`)
+	assert.ErrorContains(t, err, `survey variable "replicas": expected an integer`)
+	assert.ErrorContains(t, err, `survey variable "tags": "c" is not a valid choice`)
+	assert.ErrorContains(t, err, `survey variable "name": value is required`)
+
+	err = spec.ValidateExtraVars(map[string]any{
+		"region": "eu-de-1",
+		"name":   "too-long",
+	})
+	assert.ErrorContains(t, err, "length 8 is greater than maximum 5")
+}
@@ -0,0 +1,73 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"time"
+)
+
+// SystemJobTemplate represents a management job of AWX, e.g. the cleanup of
+// old jobs.
+type SystemJobTemplate struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Type        string `json:"type"`
+	Modified    string `json:"modified"`
+	Created     string `json:"created"`
+	JobType     string `json:"job_type"`
+	Status      string `json:"status"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// SystemJob represents a run of a system job template.
+type SystemJob struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	URL                string    `json:"url"`
+	Type               string    `json:"type"`
+	Modified           string    `json:"modified"`
+	Created            string    `json:"created"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	SystemJobTemplate  int       `json:"system_job_template"`
+	JobType            string    `json:"job_type"`
+	LaunchType         string    `json:"launch_type"`
+	Status             string    `json:"status"`
+	Failed             bool      `json:"failed"`
+	Started            time.Time `json:"started"`
+	Finished           time.Time `json:"finished"`
+	Elapsed            float64   `json:"elapsed"`
+	JobExplanation     string    `json:"job_explanation"`
+	ExtraVars          string    `json:"extra_vars"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// GetSystemJob retrieves the system job with the given id.
+func GetSystemJob(ctx context.Context, c Client, id int) (*SystemJob, error) {
+	return getByID[SystemJob](ctx, c, "system_jobs", id)
+}
+
+// GetSystemJobTemplate retrieves the system job template with the given id.
+func GetSystemJobTemplate(ctx context.Context, c Client, id int) (*SystemJobTemplate, error) {
+	return getByID[SystemJobTemplate](ctx, c, "system_job_templates", id)
+}
@@ -0,0 +1,104 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"strconv"
+)
+
+// TeamList represents the output of the ListTeams method.
+type TeamList struct {
+	ListGetResponse
+	Results []*Team `json:"results,omitempty"`
+}
+
+// Team represents the output of the GetTeams method.
+type Team struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	URL          string `json:"url,omitempty"`
+	Type         string `json:"type,omitempty"`
+	Modified     string `json:"modified,omitempty"`
+	Created      string `json:"created,omitempty"`
+	Organization int    `json:"organization"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListTeamsInput represents the input of the ListTeams method.
+type ListTeamsInput struct {
+	ID           string `schema:"id,omitempty"`
+	Name         string `schema:"name,omitempty"`
+	Organization int    `schema:"organization,omitempty"`
+}
+
+const teamsResource = "teams"
+
+func teamUsersKey(teamID int) ObjectKey {
+	return ObjectKey{Resource: teamsResource, ResourceID: strconv.Itoa(teamID), Action: "users"}
+}
+
+// GetTeam retrieves the team with the given id.
+func GetTeam(ctx context.Context, c Client, id int) (*Team, error) {
+	return getByID[Team](ctx, c, teamsResource, id)
+}
+
+// GetTeamByName retrieves the team with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several teams match.
+func GetTeamByName(ctx context.Context, c Client, name, organization string) (*Team, error) {
+	return GetByName[Team](ctx, c, teamsResource, name, organization)
+}
+
+// ListTeams retrieves all teams matching input.
+func ListTeams(ctx context.Context, c Client, input *ListTeamsInput) ([]*Team, error) {
+	return listAll[Team](ctx, c, ObjectKey{Resource: teamsResource}, input)
+}
+
+// CreateTeam creates team in AWX and updates it with the response of the server.
+func CreateTeam(ctx context.Context, c Client, team *Team) error {
+	return c.Create(ctx, ObjectKey{Resource: teamsResource}, team, nil)
+}
+
+// UpdateTeam updates team in AWX.
+func UpdateTeam(ctx context.Context, c Client, team *Team) error {
+	return c.Update(ctx, idKey(teamsResource, team.ID), team, nil)
+}
+
+// DeleteTeam deletes the team with the given id.
+func DeleteTeam(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(teamsResource, id), nil)
+}
+
+// ListTeamUsers retrieves the members of the team.
+func ListTeamUsers(ctx context.Context, c Client, teamID int) ([]*User, error) {
+	return listAll[User](ctx, c, teamUsersKey(teamID), nil)
+}
+
+// AddTeamUser makes the user a member of the team.
+func AddTeamUser(ctx context.Context, c Client, teamID, userID int) error {
+	return Associate(ctx, c, teamUsersKey(teamID), userID)
+}
+
+// RemoveTeamUser removes the user from the members of the team.
+func RemoveTeamUser(ctx context.Context, c Client, teamID, userID int) error {
+	return Disassociate(ctx, c, teamUsersKey(teamID), userID)
+}
@@ -0,0 +1,84 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeams(t *testing.T) {
+	var calls []AssociateInput
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /teams/", func(w http.ResponseWriter, r *http.Request) {
+		received := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, "ops", received["name"])
+		assert.Equal(t, float64(1), received["organization"])
+		assert.NotContains(t, received, "summary_fields")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{"id": 4, "name": "ops", "organization": 1}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /teams/4/users/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 5, "username": "alice"}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /teams/4/users/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		calls = append(calls, received)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	team := Team{Name: "ops", Organization: 1}
+	err = CreateTeam(ctx, client, &team)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, team.ID)
+
+	users, err := ListTeamUsers(ctx, client, 4)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "alice", users[0].Username)
+
+	assert.NoError(t, AddTeamUser(ctx, client, 4, 6))
+	assert.NoError(t, RemoveTeamUser(ctx, client, 4, 5))
+	assert.Equal(t, []AssociateInput{
+		{ID: 6},
+		{ID: 5, Disassociate: true},
+	}, calls)
+}
@@ -0,0 +1,324 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Types of the results of unified_jobs/.
+const (
+	UnifiedJobTypeJob              = "job"
+	UnifiedJobTypeProjectUpdate    = "project_update"
+	UnifiedJobTypeInventoryUpdate  = "inventory_update"
+	UnifiedJobTypeWorkflowJob      = "workflow_job"
+	UnifiedJobTypeWorkflowApproval = "workflow_approval"
+	UnifiedJobTypeSystemJob        = "system_job"
+	UnifiedJobTypeAdHocCommand     = "ad_hoc_command"
+)
+
+// Types of the results of unified_job_templates/.
+const (
+	UnifiedJobTemplateTypeJobTemplate         = "job_template"
+	UnifiedJobTemplateTypeWorkflowJobTemplate = "workflow_job_template"
+	UnifiedJobTemplateTypeProject             = "project"
+	UnifiedJobTemplateTypeInventorySource     = "inventory_source"
+	UnifiedJobTemplateTypeSystemJobTemplate   = "system_job_template"
+)
+
+// UnifiedJobInfo represents the fields common to all kinds of unified jobs.
+type UnifiedJobInfo struct {
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	Type     string    `json:"type"`
+	Status   string    `json:"status"`
+	Failed   bool      `json:"failed"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+}
+
+// UnifiedJob is implemented by all kinds of unified jobs: *Job,
+// *ProjectUpdate, *InventoryUpdate, *WorkflowJob, *WorkflowApproval,
+// *SystemJob, *AdHocCommand and *UnknownUnifiedJob.
+type UnifiedJob interface {
+	// Info returns the fields common to all kinds of unified jobs.
+	Info() UnifiedJobInfo
+}
+
+// UnknownUnifiedJob represents a unified job of a type this package does not
+// know. Raw holds the undecoded object.
+type UnknownUnifiedJob struct {
+	UnifiedJobInfo
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnifiedJobTemplateInfo represents the fields common to all kinds of unified
+// job templates.
+type UnifiedJobTemplateInfo struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+// UnifiedJobTemplate is implemented by all kinds of unified job templates:
+// *JobTemplate, *WorkflowJobTemplate, *Project, *InventorySource,
+// *SystemJobTemplate and *UnknownUnifiedJobTemplate.
+type UnifiedJobTemplate interface {
+	// Info returns the fields common to all kinds of unified job templates.
+	Info() UnifiedJobTemplateInfo
+}
+
+// UnknownUnifiedJobTemplate represents a unified job template of a type this
+// package does not know. Raw holds the undecoded object.
+type UnknownUnifiedJobTemplate struct {
+	UnifiedJobTemplateInfo
+	Raw json.RawMessage `json:"-"`
+}
+
+// Info implements the UnifiedJob interface.
+func (j *Job) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: j.ID, Name: j.Name, Type: j.Type, Status: j.Status, Failed: j.Failed, Started: j.Started, Finished: j.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (u *ProjectUpdate) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: u.ID, Name: u.Name, Type: u.Type, Status: u.Status, Failed: u.Failed, Started: u.Started, Finished: u.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (u *InventoryUpdate) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: u.ID, Name: u.Name, Type: u.Type, Status: u.Status, Failed: u.Failed, Started: u.Started, Finished: u.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (wj *WorkflowJob) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: wj.ID, Name: wj.Name, Type: wj.Type, Status: wj.Status, Failed: wj.Failed, Started: wj.Started, Finished: wj.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (a *WorkflowApproval) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: a.ID, Name: a.Name, Type: a.Type, Status: a.Status, Failed: a.Failed, Started: a.Started, Finished: a.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (j *SystemJob) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: j.ID, Name: j.Name, Type: j.Type, Status: j.Status, Failed: j.Failed, Started: j.Started, Finished: j.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (cmd *AdHocCommand) Info() UnifiedJobInfo {
+	return UnifiedJobInfo{ID: cmd.ID, Name: cmd.Name, Type: cmd.Type, Status: cmd.Status, Failed: cmd.Failed, Started: cmd.Started, Finished: cmd.Finished}
+}
+
+// Info implements the UnifiedJob interface.
+func (j *UnknownUnifiedJob) Info() UnifiedJobInfo {
+	return j.UnifiedJobInfo
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (jt *JobTemplate) Info() UnifiedJobTemplateInfo {
+	return UnifiedJobTemplateInfo{ID: jt.ID, Name: jt.Name, Type: jt.Type, Status: jt.Status}
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (wjt *WorkflowJobTemplate) Info() UnifiedJobTemplateInfo {
+	return UnifiedJobTemplateInfo{ID: wjt.ID, Name: wjt.Name, Type: wjt.Type, Status: wjt.Status}
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (p *Project) Info() UnifiedJobTemplateInfo {
+	return UnifiedJobTemplateInfo{ID: p.ID, Name: p.Name, Type: p.Type, Status: p.Status}
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (src *InventorySource) Info() UnifiedJobTemplateInfo {
+	return UnifiedJobTemplateInfo{ID: src.ID, Name: src.Name, Type: src.Type, Status: src.Status}
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (t *SystemJobTemplate) Info() UnifiedJobTemplateInfo {
+	return UnifiedJobTemplateInfo{ID: t.ID, Name: t.Name, Type: t.Type, Status: t.Status}
+}
+
+// Info implements the UnifiedJobTemplate interface.
+func (t *UnknownUnifiedJobTemplate) Info() UnifiedJobTemplateInfo {
+	return t.UnifiedJobTemplateInfo
+}
+
+// objectType reads the type field of a JSON object.
+func objectType(data []byte) (string, error) {
+	head := struct {
+		Type string `json:"type"`
+	}{}
+	err := json.Unmarshal(data, &head)
+	return head.Type, err
+}
+
+// DecodeUnifiedJob decodes a result of unified_jobs/ into the concrete type
+// given by its type field.
+func DecodeUnifiedJob(data []byte) (UnifiedJob, error) {
+	typ, err := objectType(data)
+	if err != nil {
+		return nil, err
+	}
+	var job UnifiedJob
+	switch typ {
+	case UnifiedJobTypeJob:
+		job = &Job{}
+	case UnifiedJobTypeProjectUpdate:
+		job = &ProjectUpdate{}
+	case UnifiedJobTypeInventoryUpdate:
+		job = &InventoryUpdate{}
+	case UnifiedJobTypeWorkflowJob:
+		job = &WorkflowJob{}
+	case UnifiedJobTypeWorkflowApproval:
+		job = &WorkflowApproval{}
+	case UnifiedJobTypeSystemJob:
+		job = &SystemJob{}
+	case UnifiedJobTypeAdHocCommand:
+		job = &AdHocCommand{}
+	default:
+		job = &UnknownUnifiedJob{Raw: append(json.RawMessage(nil), data...)}
+	}
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// DecodeUnifiedJobTemplate decodes a result of unified_job_templates/ into
+// the concrete type given by its type field.
+func DecodeUnifiedJobTemplate(data []byte) (UnifiedJobTemplate, error) {
+	typ, err := objectType(data)
+	if err != nil {
+		return nil, err
+	}
+	var template UnifiedJobTemplate
+	switch typ {
+	case UnifiedJobTemplateTypeJobTemplate:
+		template = &JobTemplate{}
+	case UnifiedJobTemplateTypeWorkflowJobTemplate:
+		template = &WorkflowJobTemplate{}
+	case UnifiedJobTemplateTypeProject:
+		template = &Project{}
+	case UnifiedJobTemplateTypeInventorySource:
+		template = &InventorySource{}
+	case UnifiedJobTemplateTypeSystemJobTemplate:
+		template = &SystemJobTemplate{}
+	default:
+		template = &UnknownUnifiedJobTemplate{Raw: append(json.RawMessage(nil), data...)}
+	}
+	if err := json.Unmarshal(data, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// unifiedJobResult decodes a result of unified_jobs/ through DecodeUnifiedJob.
+type unifiedJobResult struct {
+	UnifiedJob
+}
+
+func (r *unifiedJobResult) UnmarshalJSON(data []byte) (err error) {
+	r.UnifiedJob, err = DecodeUnifiedJob(data)
+	return err
+}
+
+// unifiedJobTemplateResult decodes a result of unified_job_templates/ through
+// DecodeUnifiedJobTemplate.
+type unifiedJobTemplateResult struct {
+	UnifiedJobTemplate
+}
+
+func (r *unifiedJobTemplateResult) UnmarshalJSON(data []byte) (err error) {
+	r.UnifiedJobTemplate, err = DecodeUnifiedJobTemplate(data)
+	return err
+}
+
+// ListUnifiedJobsInput represents the input of the ListUnifiedJobs method.
+type ListUnifiedJobsInput struct {
+	Name               string `schema:"name,omitempty"`
+	Type               string `schema:"type,omitempty"`
+	Status             string `schema:"status,omitempty"`
+	UnifiedJobTemplate int    `schema:"unified_job_template,omitempty"`
+	OrderBy            string `schema:"order_by,omitempty"`
+}
+
+// ListUnifiedJobTemplatesInput represents the input of the ListUnifiedJobTemplates method.
+type ListUnifiedJobTemplatesInput struct {
+	Name    string `schema:"name,omitempty"`
+	Type    string `schema:"type,omitempty"`
+	Status  string `schema:"status,omitempty"`
+	OrderBy string `schema:"order_by,omitempty"`
+}
+
+// ListUnifiedJobs retrieves all unified jobs matching input, each decoded
+// into its concrete type.
+func ListUnifiedJobs(ctx context.Context, c Client, input *ListUnifiedJobsInput) ([]UnifiedJob, error) {
+	results, err := listAll[unifiedJobResult](ctx, c, ObjectKey{Resource: "unified_jobs"}, input)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]UnifiedJob, len(results))
+	for i, r := range results {
+		jobs[i] = r.UnifiedJob
+	}
+	return jobs, nil
+}
+
+// ListRecentUnifiedJobs retrieves the n most recently created unified jobs,
+// newest first, without paging through all jobs.
+func ListRecentUnifiedJobs(ctx context.Context, c Client, n int) ([]UnifiedJob, error) {
+	var jobs []UnifiedJob
+	pageSize := min(n, maxPageSize)
+	for page := 1; len(jobs) < n; page++ {
+		list := struct {
+			ListGetResponse
+			Results []*unifiedJobResult `json:"results,omitempty"`
+		}{}
+		opts := pageOptions{opts: &ListUnifiedJobsInput{OrderBy: "-created"}, page: page, pageSize: pageSize}
+		if err := c.List(ctx, ObjectKey{Resource: "unified_jobs"}, &list, opts, nil); err != nil {
+			return nil, err
+		}
+		for _, r := range list.Results[:min(len(list.Results), n-len(jobs))] {
+			jobs = append(jobs, r.UnifiedJob)
+		}
+		if list.Next == "" {
+			break
+		}
+	}
+	return jobs, nil
+}
+
+// ListUnifiedJobTemplates retrieves all unified job templates matching
+// input, each decoded into its concrete type.
+func ListUnifiedJobTemplates(ctx context.Context, c Client, input *ListUnifiedJobTemplatesInput) ([]UnifiedJobTemplate, error) {
+	results, err := listAll[unifiedJobTemplateResult](ctx, c, ObjectKey{Resource: "unified_job_templates"}, input)
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]UnifiedJobTemplate, len(results))
+	for i, r := range results {
+		templates[i] = r.UnifiedJobTemplate
+	}
+	return templates, nil
+}
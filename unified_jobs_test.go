@@ -0,0 +1,145 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListUnifiedJobs(t *testing.T) {
+	writeJSON := func(w http.ResponseWriter, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /unified_jobs/", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "-created", q.Get("order_by"))
+		if q.Get("page_size") == "3" {
+			writeJSON(w, `{"results": [{"id": 5, "type": "system_job", "name": "Cleanup", "status": "running", "job_type": "cleanup_jobs"}]}`)
+			return
+		}
+		writeJSON(w, `{"results": [
+			{"id": 1, "type": "job", "name": "deploy", "status": "successful",
+			 "started": "2025-01-01T10:00:00Z", "finished": "2025-01-01T10:05:00Z"},
+			{"id": 2, "type": "project_update", "name": "playbooks", "status": "failed", "failed": true, "scm_revision": "abc"},
+			{"id": 3, "type": "inventory_update", "name": "openstack", "status": "successful", "source": "openstack"},
+			{"id": 4, "type": "workflow_job", "name": "release", "status": "running"},
+			{"id": 5, "type": "system_job", "name": "Cleanup", "status": "running", "job_type": "cleanup_jobs"},
+			{"id": 6, "type": "ad_hoc_command", "name": "ping", "status": "pending", "module_name": "ping"},
+			{"id": 7, "type": "future_job", "name": "new", "status": "new"}
+		]}`)
+	})
+	mux.HandleFunc("GET /unified_job_templates/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"results": [
+			{"id": 10, "type": "job_template", "name": "deploy", "status": "successful"},
+			{"id": 11, "type": "workflow_job_template", "name": "release", "status": "never updated"},
+			{"id": 12, "type": "project", "name": "playbooks", "status": "failed", "scm_type": "git"},
+			{"id": 13, "type": "inventory_source", "name": "openstack", "status": "successful", "inventory": 3},
+			{"id": 14, "type": "system_job_template", "name": "Cleanup", "status": "ok", "job_type": "cleanup_jobs"}
+		]}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	jobs, err := ListUnifiedJobs(context.Background(), client, &ListUnifiedJobsInput{OrderBy: "-created"})
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 7)
+	assert.IsType(t, &Job{}, jobs[0])
+	assert.Equal(t, "abc", jobs[1].(*ProjectUpdate).SCMRevision)
+	assert.Equal(t, "openstack", jobs[2].(*InventoryUpdate).Source)
+	assert.IsType(t, &WorkflowJob{}, jobs[3])
+	assert.Equal(t, "cleanup_jobs", jobs[4].(*SystemJob).JobType)
+	assert.Equal(t, "ping", jobs[5].(*AdHocCommand).ModuleName)
+	assert.Contains(t, string(jobs[6].(*UnknownUnifiedJob).Raw), "future_job")
+
+	info := jobs[0].Info()
+	assert.Equal(t, UnifiedJobInfo{
+		ID:       1,
+		Name:     "deploy",
+		Type:     UnifiedJobTypeJob,
+		Status:   JobStatusSuccessful,
+		Started:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		Finished: time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC),
+	}, info)
+	assert.True(t, jobs[1].Info().Failed)
+	assert.Equal(t, "future_job", jobs[6].Info().Type)
+
+	jobs, err = ListRecentUnifiedJobs(context.Background(), client, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, jobs[0].Info().ID)
+
+	templates, err := ListUnifiedJobTemplates(context.Background(), client, nil)
+	assert.NoError(t, err)
+	var types []string
+	for _, tmpl := range templates {
+		types = append(types, tmpl.Info().Type)
+	}
+	assert.Equal(t, []string{"job_template", "workflow_job_template", "project", "inventory_source", "system_job_template"}, types)
+	assert.Equal(t, "git", templates[2].(*Project).SCMType)
+	assert.Equal(t, 3, templates[3].(*InventorySource).Inventory)
+}
+
+func TestListRecentUnifiedJobsPages(t *testing.T) {
+	var pages []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /unified_jobs/", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "200", q.Get("page_size"))
+		pages = append(pages, q.Get("page"))
+		page, err := strconv.Atoi(q.Get("page"))
+		assert.NoError(t, err)
+		var results []string
+		for id := 1000 - 200*(page-1); id > 1000-200*page; id-- {
+			results = append(results, fmt.Sprintf(`{"id": %d, "type": "job"}`, id))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err = fmt.Fprintf(w, `{"count": 1000, "next": "/unified_jobs/?page=%d", "results": [%s]}`, page+1, strings.Join(results, ","))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	jobs, err := ListRecentUnifiedJobs(context.Background(), client, 250)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 250)
+	assert.Equal(t, 1000, jobs[0].Info().ID)
+	assert.Equal(t, 751, jobs[249].Info().ID)
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
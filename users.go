@@ -0,0 +1,116 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// UserList represents the output of the ListUsers method.
+type UserList struct {
+	ListGetResponse
+	Results []*User `json:"results,omitempty"`
+}
+
+// User represents the output of the GetUsers method.
+type User struct {
+	ID              int    `json:"id,omitempty"`
+	Username        string `json:"username"`
+	FirstName       string `json:"first_name"`
+	LastName        string `json:"last_name"`
+	Email           string `json:"email"`
+	URL             string `json:"url,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Modified        string `json:"modified,omitempty"`
+	Created         string `json:"created,omitempty"`
+	IsSuperuser     bool   `json:"is_superuser"`
+	IsSystemAuditor bool   `json:"is_system_auditor"`
+	// Password is returned as EncryptedValue. AWX keeps the current password
+	// when EncryptedValue is sent back.
+	Password        Secret  `json:"password,omitempty"`
+	LDAPDN          string  `json:"ldap_dn,omitempty"`
+	LastLogin       string  `json:"last_login,omitempty"`
+	ExternalAccount *string `json:"external_account,omitempty"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListUsersInput represents the input of the ListUsers method.
+type ListUsersInput struct {
+	ID       string `schema:"id,omitempty"`
+	Username string `schema:"username,omitempty"`
+	Email    string `schema:"email,omitempty"`
+}
+
+const usersResource = "users"
+
+// GetUser retrieves the user with the given id.
+func GetUser(ctx context.Context, c Client, id int) (*User, error) {
+	return getByID[User](ctx, c, usersResource, id)
+}
+
+// GetUserByName retrieves the user with the given username. The error
+// matches ErrNotFound if it does not exist.
+func GetUserByName(ctx context.Context, c Client, username string) (*User, error) {
+	return GetByName[User](ctx, c, usersResource, username, "")
+}
+
+// GetMe retrieves the user the client is authenticated as.
+func GetMe(ctx context.Context, c Client) (*User, error) {
+	list := UserList{}
+	if err := c.List(ctx, ObjectKey{Resource: "me"}, &list, nil, nil); err != nil {
+		return nil, err
+	}
+	if len(list.Results) == 0 {
+		return nil, errors.New("the authenticated user is unknown")
+	}
+	return list.Results[0], nil
+}
+
+// ListUsers retrieves all users matching input.
+func ListUsers(ctx context.Context, c Client, input *ListUsersInput) ([]*User, error) {
+	return listAll[User](ctx, c, ObjectKey{Resource: usersResource}, input)
+}
+
+// CreateUser creates user in AWX and updates it with the response of the server.
+func CreateUser(ctx context.Context, c Client, user *User) error {
+	return c.Create(ctx, ObjectKey{Resource: usersResource}, user, nil)
+}
+
+// UpdateUser updates user in AWX. The password is only changed when set.
+func UpdateUser(ctx context.Context, c Client, user *User) error {
+	return c.Update(ctx, idKey(usersResource, user.ID), user, nil)
+}
+
+// DeleteUser deletes the user with the given id.
+func DeleteUser(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(usersResource, id), nil)
+}
+
+// ListUserTeams retrieves the teams the user is a member of.
+func ListUserTeams(ctx context.Context, c Client, userID int) ([]*Team, error) {
+	return listAll[Team](ctx, c, ObjectKey{Resource: usersResource, ResourceID: strconv.Itoa(userID), Action: teamsResource}, nil)
+}
+
+// ListUserOrganizations retrieves the organizations the user is a member of.
+func ListUserOrganizations(ctx context.Context, c Client, userID int) ([]*Organization, error) {
+	return listAll[Organization](ctx, c, ObjectKey{Resource: usersResource, ResourceID: strconv.Itoa(userID), Action: organizationsResource}, nil)
+}
@@ -0,0 +1,79 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsers(t *testing.T) {
+	var updated map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /me/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 5, "username": "alice", "password": "$encrypted$", "is_superuser": true}]}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("PUT /users/5/", func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&updated)
+		assert.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write([]byte(`{"id": 5, "username": "alice", "email": "alice@example.com", "password": "$encrypted$"}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /users/5/teams/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "results": [{"id": 4, "name": "ops", "organization": 1}]}`))
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	me, err := GetMe(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", me.Username)
+	assert.True(t, me.IsSuperuser)
+	assert.Equal(t, Secret(EncryptedValue), me.Password)
+
+	me.Email = "alice@example.com"
+	err = UpdateUser(ctx, client, me)
+	assert.NoError(t, err)
+	assert.Equal(t, EncryptedValue, updated["password"])
+	assert.Equal(t, "alice@example.com", updated["email"])
+
+	teams, err := ListUserTeams(ctx, client, 5)
+	assert.NoError(t, err)
+	assert.Len(t, teams, 1)
+	assert.Equal(t, "ops", teams[0].Name)
+}
@@ -0,0 +1,110 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Variables represents the variables of an inventory, host or group. AWX
+// stores them as a YAML or JSON string; Variables decodes that string into a
+// map and encodes it back as JSON.
+type Variables map[string]any
+
+// ParseVariables parses variables given as YAML or JSON.
+func ParseVariables(s string) (Variables, error) {
+	vars := Variables{}
+	if strings.TrimSpace(s) == "" {
+		return vars, nil
+	}
+	// JSON is a subset of YAML, so a single decoder handles both.
+	if err := yaml.Unmarshal([]byte(s), &vars); err != nil {
+		return nil, fmt.Errorf("invalid variables: %w", err)
+	}
+	return vars, nil
+}
+
+// YAML returns the variables encoded as YAML.
+func (v Variables) YAML() (string, error) {
+	if len(v) == 0 {
+		return "", nil
+	}
+	out, err := yaml.Marshal(map[string]any(v))
+	if err != nil {
+		return "", err
+	}
+	return "---\n" + string(out), nil
+}
+
+// JSON returns the variables encoded as JSON.
+func (v Variables) JSON() (string, error) {
+	if len(v) == 0 {
+		return "", nil
+	}
+	out, err := json.Marshal(map[string]any(v))
+	return string(out), err
+}
+
+// MarshalJSON encodes the variables as a JSON string, as expected by AWX.
+func (v Variables) MarshalJSON() ([]byte, error) {
+	s, err := v.JSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON decodes the variables string returned by AWX.
+func (v *Variables) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		// Some endpoints (e.g. variable_data) return the variables as object.
+		m := map[string]any{}
+		if err := json.Unmarshal(data, &m); err != nil {
+			return fmt.Errorf("invalid variables: %s", string(data))
+		}
+		*v = m
+		return nil
+	}
+	vars, err := ParseVariables(s)
+	if err != nil {
+		return err
+	}
+	*v = vars
+	return nil
+}
+
+// ExtraData represents the extra variables of a schedule or workflow node.
+// Unlike Variables it is sent to AWX as an object, but a YAML or JSON string
+// is accepted when decoding.
+type ExtraData map[string]any
+
+// UnmarshalJSON decodes extra data given either as object or as string.
+func (e *ExtraData) UnmarshalJSON(data []byte) error {
+	var vars Variables
+	if err := vars.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*e = ExtraData(vars)
+	return nil
+}
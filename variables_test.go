@@ -0,0 +1,45 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariablesRoundTrip(t *testing.T) {
+	vars, err := ParseVariables("---\nntp:\n  - 10.0.0.1\nport: 22\n")
+	assert.NoError(t, err)
+	assert.Equal(t, Variables{"ntp": []any{"10.0.0.1"}, "port": 22}, vars)
+
+	out, err := vars.YAML()
+	assert.NoError(t, err)
+	assert.Equal(t, "---\nntp:\n    - 10.0.0.1\nport: 22\n", out)
+
+	out, err = vars.JSON()
+	assert.NoError(t, err)
+	again, err := ParseVariables(out)
+	assert.NoError(t, err)
+	assert.Equal(t, vars, again)
+
+	vars, err = ParseVariables("")
+	assert.NoError(t, err)
+	assert.Equal(t, Variables{}, vars)
+}
@@ -0,0 +1,166 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WorkflowApprovalList represents the output of the ListWorkflowApprovals method.
+type WorkflowApprovalList struct {
+	ListGetResponse
+	Results []*WorkflowApproval `json:"results,omitempty"`
+}
+
+// WorkflowApproval represents a pending or decided approval of a workflow job.
+type WorkflowApproval struct {
+	ID                 int                           `json:"id"`
+	Name               string                        `json:"name"`
+	Description        string                        `json:"description"`
+	URL                string                        `json:"url"`
+	Type               string                        `json:"type"`
+	Modified           string                        `json:"modified"`
+	Created            string                        `json:"created"`
+	UnifiedJobTemplate int                           `json:"unified_job_template"`
+	Status             string                        `json:"status"`
+	Failed             bool                          `json:"failed"`
+	Started            time.Time                     `json:"started"`
+	Finished           time.Time                     `json:"finished"`
+	JobExplanation     string                        `json:"job_explanation"`
+	CanApproveOrDeny   bool                          `json:"can_approve_or_deny"`
+	ApprovalExpiration *time.Time                    `json:"approval_expiration"`
+	TimedOut           bool                          `json:"timed_out"`
+	SummaryFields      WorkflowApprovalSummaryFields `json:"summary_fields"`
+}
+
+// WorkflowApprovalSummaryFields represents the summary fields of a workflow approval.
+type WorkflowApprovalSummaryFields struct {
+	SourceWorkflowJob  *JobSummary `json:"source_workflow_job"`
+	ApprovedOrDeniedBy *struct {
+		ID       int    `json:"id"`
+		Username string `json:"username"`
+	} `json:"approved_or_denied_by"`
+	UserCapabilities UserCapabilities `json:"user_capabilities"`
+}
+
+// WorkflowApprovalTemplateList represents the output of the ListWorkflowApprovalTemplates method.
+type WorkflowApprovalTemplateList struct {
+	ListGetResponse
+	Results []*WorkflowApprovalTemplate `json:"results,omitempty"`
+}
+
+// WorkflowApprovalTemplate represents the template of an approval node.
+type WorkflowApprovalTemplate struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	URL         string `json:"url,omitempty"`
+	Type        string `json:"type,omitempty"`
+	// Timeout is the number of seconds after which the approval times out,
+	// 0 means never.
+	Timeout int `json:"timeout"`
+}
+
+// ListWorkflowApprovalsInput represents the input of the ListWorkflowApprovals method.
+type ListWorkflowApprovalsInput struct {
+	ID     string `schema:"id,omitempty"`
+	Name   string `schema:"name,omitempty"`
+	Status string `schema:"status,omitempty"`
+}
+
+// WorkflowApprovalState represents the outcome of a workflow approval.
+type WorkflowApprovalState string
+
+// States of a workflow approval.
+const (
+	WorkflowApprovalPending  WorkflowApprovalState = "pending"
+	WorkflowApprovalApproved WorkflowApprovalState = "approved"
+	WorkflowApprovalDenied   WorkflowApprovalState = "denied"
+	WorkflowApprovalTimedOut WorkflowApprovalState = "timed_out"
+	WorkflowApprovalCanceled WorkflowApprovalState = "canceled"
+)
+
+// State returns the outcome of the approval. AWX reports denied and timed out
+// approvals both as failed, they are told apart by the timed_out flag.
+func (a *WorkflowApproval) State() WorkflowApprovalState {
+	switch a.Status {
+	case JobStatusSuccessful:
+		return WorkflowApprovalApproved
+	case JobStatusFailed, JobStatusError:
+		if a.TimedOut {
+			return WorkflowApprovalTimedOut
+		}
+		return WorkflowApprovalDenied
+	case JobStatusCanceled:
+		return WorkflowApprovalCanceled
+	default:
+		return WorkflowApprovalPending
+	}
+}
+
+// GetWorkflowApproval retrieves the workflow approval with the given id.
+func GetWorkflowApproval(ctx context.Context, c Client, id int) (*WorkflowApproval, error) {
+	return getByID[WorkflowApproval](ctx, c, "workflow_approvals", id)
+}
+
+// ListWorkflowApprovals retrieves all workflow approvals matching input.
+func ListWorkflowApprovals(ctx context.Context, c Client, input *ListWorkflowApprovalsInput) ([]*WorkflowApproval, error) {
+	return listAll[WorkflowApproval](ctx, c, ObjectKey{Resource: "workflow_approvals"}, input)
+}
+
+// ListPendingWorkflowApprovals retrieves all workflow approvals waiting for a
+// decision.
+func ListPendingWorkflowApprovals(ctx context.Context, c Client) ([]*WorkflowApproval, error) {
+	return ListWorkflowApprovals(ctx, c, &ListWorkflowApprovalsInput{Status: JobStatusPending})
+}
+
+// ApproveWorkflowApproval approves the workflow approval with the given id.
+func ApproveWorkflowApproval(ctx context.Context, c Client, id int) error {
+	return decideWorkflowApproval(ctx, c, id, "approve")
+}
+
+// DenyWorkflowApproval denies the workflow approval with the given id.
+func DenyWorkflowApproval(ctx context.Context, c Client, id int) error {
+	return decideWorkflowApproval(ctx, c, id, "deny")
+}
+
+func decideWorkflowApproval(ctx context.Context, c Client, id int, action string) error {
+	key := ObjectKey{Resource: "workflow_approvals", ResourceID: strconv.Itoa(id), Action: action}
+	return c.Create(ctx, key, &struct{}{}, []int{http.StatusNoContent, http.StatusOK})
+}
+
+// GetWorkflowApprovalTemplate retrieves the workflow approval template with the given id.
+func GetWorkflowApprovalTemplate(ctx context.Context, c Client, id int) (*WorkflowApprovalTemplate, error) {
+	return getByID[WorkflowApprovalTemplate](ctx, c, "workflow_approval_templates", id)
+}
+
+// UpdateWorkflowApprovalTemplate updates tpl in AWX.
+func UpdateWorkflowApprovalTemplate(ctx context.Context, c Client, tpl *WorkflowApprovalTemplate) error {
+	return c.Update(ctx, idKey("workflow_approval_templates", tpl.ID), tpl, nil)
+}
+
+// CreateWorkflowApprovalTemplate turns the workflow job template node with the
+// given id into an approval node and updates tpl with the response of the server.
+func CreateWorkflowApprovalTemplate(ctx context.Context, c Client, nodeID int, tpl *WorkflowApprovalTemplate) error {
+	key := ObjectKey{Resource: "workflow_job_template_nodes", ResourceID: strconv.Itoa(nodeID), Action: "create_approval_template"}
+	return c.Create(ctx, key, tpl, []int{http.StatusCreated, http.StatusOK})
+}
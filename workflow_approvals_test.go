@@ -0,0 +1,81 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPendingWorkflowApprovals(t *testing.T) {
+	var approved []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workflow_approvals/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pending", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{
+			"count": 1,
+			"results": [
+				{
+					"id": 3,
+					"name": "approve production",
+					"status": "pending",
+					"can_approve_or_deny": true,
+					"approval_expiration": "2025-01-01T01:00:00Z",
+					"summary_fields": {"source_workflow_job": {"id": 5, "name": "deploy", "status": "running"}}
+				}
+			]
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /workflow_approvals/{id}/approve/", func(w http.ResponseWriter, r *http.Request) {
+		approved = append(approved, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	approvals, err := ListPendingWorkflowApprovals(context.Background(), client)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(approvals))
+	assert.Equal(t, WorkflowApprovalPending, approvals[0].State())
+	assert.Equal(t, 5, approvals[0].SummaryFields.SourceWorkflowJob.ID)
+	assert.Equal(t, 1, approvals[0].ApprovalExpiration.Hour())
+
+	err = ApproveWorkflowApproval(context.Background(), client, approvals[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, approved)
+}
+
+func TestWorkflowApprovalState(t *testing.T) {
+	assert.Equal(t, WorkflowApprovalApproved, (&WorkflowApproval{Status: "successful"}).State())
+	assert.Equal(t, WorkflowApprovalDenied, (&WorkflowApproval{Status: "failed"}).State())
+	assert.Equal(t, WorkflowApprovalTimedOut, (&WorkflowApproval{Status: "failed", TimedOut: true}).State())
+	assert.Equal(t, WorkflowApprovalCanceled, (&WorkflowApproval{Status: "canceled"}).State())
+}
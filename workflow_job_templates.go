@@ -0,0 +1,122 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// WorkflowJobTemplateList represents the output of the ListWorkflowJobTemplates method.
+type WorkflowJobTemplateList struct {
+	ListGetResponse
+	Results []*WorkflowJobTemplate `json:"results,omitempty"`
+}
+
+// WorkflowJobTemplate represents the output of the GetWorkflowJobTemplates method.
+type WorkflowJobTemplate struct {
+	ID                   int    `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	URL                  string `json:"url,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	Modified             string `json:"modified,omitempty"`
+	Created              string `json:"created,omitempty"`
+	Status               string `json:"status,omitempty"`
+	Organization         *int   `json:"organization"`
+	Inventory            *int   `json:"inventory"`
+	Limit                string `json:"limit"`
+	SCMBranch            string `json:"scm_branch"`
+	ExtraVars            string `json:"extra_vars"`
+	SurveyEnabled        bool   `json:"survey_enabled"`
+	AllowSimultaneous    bool   `json:"allow_simultaneous"`
+	AskVariablesOnLaunch bool   `json:"ask_variables_on_launch"`
+	AskInventoryOnLaunch bool   `json:"ask_inventory_on_launch"`
+	AskLimitOnLaunch     bool   `json:"ask_limit_on_launch"`
+	AskSCMBranchOnLaunch bool   `json:"ask_scm_branch_on_launch"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// ListWorkflowJobTemplatesInput represents the input of the ListWorkflowJobTemplates method.
+type ListWorkflowJobTemplatesInput struct {
+	ID        string `schema:"id,omitempty"`
+	Name      string `schema:"name,omitempty"`
+	Inventory int    `schema:"inventory,omitempty"`
+}
+
+// LaunchWorkflowJobTemplateInput represents the input of the LaunchWorkflowJobTemplate method.
+type LaunchWorkflowJobTemplateInput struct {
+	ExtraVars map[string]any `json:"extra_vars,omitempty"`
+	Inventory int            `json:"inventory,omitempty"`
+	Limit     string         `json:"limit,omitempty"`
+	SCMBranch string         `json:"scm_branch,omitempty"`
+}
+
+// LaunchWorkflowJobTemplateOutput represents the output of the LaunchWorkflowJobTemplate method.
+type LaunchWorkflowJobTemplateOutput struct {
+	ID            int            `json:"id"`
+	WorkflowJob   int            `json:"workflow_job"`
+	Status        string         `json:"status"`
+	IgnoredFields map[string]any `json:"ignored_fields"`
+}
+
+const workflowJobTemplatesResource = "workflow_job_templates"
+
+// GetWorkflowJobTemplate retrieves the workflow job template with the given id.
+func GetWorkflowJobTemplate(ctx context.Context, c Client, id int) (*WorkflowJobTemplate, error) {
+	return getByID[WorkflowJobTemplate](ctx, c, workflowJobTemplatesResource, id)
+}
+
+// GetWorkflowJobTemplateByName retrieves the workflow job template with the given name in the given organization.
+// An empty organization matches all organizations. The error matches
+// ErrNotFound or ErrAmbiguous if no or several workflow job templates match.
+func GetWorkflowJobTemplateByName(ctx context.Context, c Client, name, organization string) (*WorkflowJobTemplate, error) {
+	return GetByName[WorkflowJobTemplate](ctx, c, workflowJobTemplatesResource, name, organization)
+}
+
+// CreateWorkflowJobTemplate creates wjt in AWX and updates it with the
+// response of the server.
+func CreateWorkflowJobTemplate(ctx context.Context, c Client, wjt *WorkflowJobTemplate) error {
+	return c.Create(ctx, ObjectKey{Resource: workflowJobTemplatesResource}, wjt, nil)
+}
+
+// UpdateWorkflowJobTemplate updates wjt in AWX.
+func UpdateWorkflowJobTemplate(ctx context.Context, c Client, wjt *WorkflowJobTemplate) error {
+	return c.Update(ctx, idKey(workflowJobTemplatesResource, wjt.ID), wjt, nil)
+}
+
+// DeleteWorkflowJobTemplate deletes the workflow job template with the given id.
+func DeleteWorkflowJobTemplate(ctx context.Context, c Client, id int) error {
+	return c.Delete(ctx, idKey(workflowJobTemplatesResource, id), nil)
+}
+
+// LaunchWorkflowJobTemplate launches the workflow job template with the given id.
+func LaunchWorkflowJobTemplate(ctx context.Context, c Client, id int, input *LaunchWorkflowJobTemplateInput) (*LaunchWorkflowJobTemplateOutput, error) {
+	if input == nil {
+		input = &LaunchWorkflowJobTemplateInput{}
+	}
+	output := &LaunchWorkflowJobTemplateOutput{}
+	key := ObjectKey{Resource: workflowJobTemplatesResource, ResourceID: strconv.Itoa(id), Action: "launch"}
+	if err := post(ctx, c, key, input, output, []int{http.StatusCreated}); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
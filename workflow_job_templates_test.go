@@ -0,0 +1,69 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaunchWorkflowJobTemplate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /workflow_job_templates/7/launch/", func(w http.ResponseWriter, r *http.Request) {
+		var received LaunchWorkflowJobTemplateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		assert.Equal(t, "web*", received.Limit)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{"id": 42, "workflow_job": 42, "status": "pending", "ignored_fields": {}}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("PUT /workflow_job_templates/7/", func(w http.ResponseWriter, r *http.Request) {
+		var received WorkflowJobTemplate
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		received.Modified = "2025-01-02T00:00:00Z"
+		w.WriteHeader(http.StatusOK)
+		err = json.NewEncoder(w).Encode(received)
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	output, err := LaunchWorkflowJobTemplate(context.Background(), client, 7, &LaunchWorkflowJobTemplateInput{Limit: "web*"})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, output.WorkflowJob)
+	assert.Equal(t, "pending", output.Status)
+
+	wjt := WorkflowJobTemplate{ID: 7, Name: "deploy"}
+	err = UpdateWorkflowJobTemplate(context.Background(), client, &wjt)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-01-02T00:00:00Z", wjt.Modified)
+}
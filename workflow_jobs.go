@@ -0,0 +1,241 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// WorkflowJobList represents the output of the ListWorkflowJobs method.
+type WorkflowJobList struct {
+	ListGetResponse
+	Results []*WorkflowJob `json:"results,omitempty"`
+}
+
+// WorkflowJob represents the output of the GetWorkflowJobs method.
+type WorkflowJob struct {
+	ID                  int       `json:"id"`
+	Name                string    `json:"name"`
+	URL                 string    `json:"url"`
+	Type                string    `json:"type"`
+	Modified            string    `json:"modified"`
+	Created             string    `json:"created"`
+	UnifiedJobTemplate  int       `json:"unified_job_template"`
+	WorkflowJobTemplate int       `json:"workflow_job_template"`
+	LaunchType          string    `json:"launch_type"`
+	Status              string    `json:"status"`
+	Failed              bool      `json:"failed"`
+	Started             time.Time `json:"started"`
+	Finished            time.Time `json:"finished"`
+	Elapsed             float64   `json:"elapsed"`
+	ExtraVars           string    `json:"extra_vars"`
+	Inventory           *int      `json:"inventory"`
+	Limit               string    `json:"limit"`
+	JobExplanation      string    `json:"job_explanation"`
+
+	SummaryFields *ObjectSummaryFields `json:"summary_fields,omitempty"`
+}
+
+// WorkflowJobNodeList represents the output of the ListWorkflowJobNodes method.
+type WorkflowJobNodeList struct {
+	ListGetResponse
+	Results []*WorkflowJobNode `json:"results,omitempty"`
+}
+
+// WorkflowJobNode represents a node of a running or finished workflow job.
+type WorkflowJobNode struct {
+	ID                 int                          `json:"id"`
+	URL                string                       `json:"url"`
+	Type               string                       `json:"type"`
+	Identifier         string                       `json:"identifier"`
+	WorkflowJob        int                          `json:"workflow_job"`
+	UnifiedJobTemplate *int                         `json:"unified_job_template"`
+	Job                *int                         `json:"job"`
+	DoNotRun           bool                         `json:"do_not_run"`
+	SuccessNodes       []int                        `json:"success_nodes"`
+	FailureNodes       []int                        `json:"failure_nodes"`
+	AlwaysNodes        []int                        `json:"always_nodes"`
+	SummaryFields      WorkflowJobNodeSummaryFields `json:"summary_fields"`
+}
+
+// WorkflowJobNodeSummaryFields represents the summary fields of a workflow job node.
+type WorkflowJobNodeSummaryFields struct {
+	Job *JobSummary `json:"job"`
+}
+
+// JobSummary represents the summary of a unified job referenced by another object.
+type JobSummary struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	Status  string  `json:"status"`
+	Failed  bool    `json:"failed"`
+	Elapsed float64 `json:"elapsed"`
+}
+
+// Workflow node states, in addition to the job statuses of nodes which
+// spawned a job.
+const (
+	// WorkflowNodeStatePending is the state of a node which did not run yet.
+	WorkflowNodeStatePending = "pending"
+	// WorkflowNodeStateSkipped is the state of a node which will not run
+	// because its parents took another path.
+	WorkflowNodeStateSkipped = "skipped"
+)
+
+// WorkflowJobNodeState represents a node of a workflow job tree.
+type WorkflowJobNodeState struct {
+	Node  *WorkflowJobNode
+	State string
+	// Job is the spawned job, only set for nodes running a job template.
+	Job     *Job
+	Success []*WorkflowJobNodeState
+	Failure []*WorkflowJobNodeState
+	Always  []*WorkflowJobNodeState
+}
+
+// WorkflowJobTree represents the resolved node states of a workflow job.
+type WorkflowJobTree struct {
+	WorkflowJob *WorkflowJob
+	Roots       []*WorkflowJobNodeState
+	Nodes       []*WorkflowJobNodeState
+}
+
+// WorkflowNodeTransition represents the state change of a workflow job node.
+type WorkflowNodeTransition struct {
+	Node     *WorkflowJobNode
+	OldState string
+	NewState string
+}
+
+// GetWorkflowJob retrieves the workflow job with the given id.
+func GetWorkflowJob(ctx context.Context, c Client, id int) (*WorkflowJob, error) {
+	return getByID[WorkflowJob](ctx, c, "workflow_jobs", id)
+}
+
+// ListWorkflowJobNodes retrieves all nodes of the given workflow job.
+func ListWorkflowJobNodes(ctx context.Context, c Client, workflowJobID int) ([]*WorkflowJobNode, error) {
+	return listAll[WorkflowJobNode](ctx, c, ObjectKey{
+		Resource:   "workflow_jobs",
+		ResourceID: strconv.Itoa(workflowJobID),
+		Action:     "workflow_nodes",
+	}, nil)
+}
+
+// nodeState returns the state of a workflow job node.
+func nodeState(n *WorkflowJobNode) string {
+	switch {
+	case n.SummaryFields.Job != nil:
+		return n.SummaryFields.Job.Status
+	case n.DoNotRun:
+		return WorkflowNodeStateSkipped
+	default:
+		return WorkflowNodeStatePending
+	}
+}
+
+// GetWorkflowJobTree retrieves the workflow job with the given id and
+// resolves its nodes into a tree. The jobs spawned from job templates are
+// retrieved as well.
+func GetWorkflowJobTree(ctx context.Context, c Client, id int) (*WorkflowJobTree, error) {
+	wj, err := GetWorkflowJob(ctx, c, id)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := ListWorkflowJobNodes(ctx, c, id)
+	if err != nil {
+		return nil, err
+	}
+	tree := newWorkflowJobTree(wj, nodes)
+	for _, n := range tree.Nodes {
+		if n.Node.Job == nil || n.Node.SummaryFields.Job == nil || n.Node.SummaryFields.Job.Type != "job" {
+			continue
+		}
+		if n.Job, err = GetJob(ctx, c, *n.Node.Job); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+func newWorkflowJobTree(wj *WorkflowJob, nodes []*WorkflowJobNode) *WorkflowJobTree {
+	tree := &WorkflowJobTree{WorkflowJob: wj}
+	states := map[int]*WorkflowJobNodeState{}
+	for _, n := range nodes {
+		s := &WorkflowJobNodeState{Node: n, State: nodeState(n)}
+		states[n.ID] = s
+		tree.Nodes = append(tree.Nodes, s)
+	}
+	hasParent := map[int]bool{}
+	resolve := func(ids []int) []*WorkflowJobNodeState {
+		var children []*WorkflowJobNodeState
+		for _, id := range ids {
+			if s := states[id]; s != nil {
+				children = append(children, s)
+				hasParent[id] = true
+			}
+		}
+		return children
+	}
+	for _, s := range tree.Nodes {
+		s.Success = resolve(s.Node.SuccessNodes)
+		s.Failure = resolve(s.Node.FailureNodes)
+		s.Always = resolve(s.Node.AlwaysNodes)
+	}
+	for _, s := range tree.Nodes {
+		if !hasParent[s.Node.ID] {
+			tree.Roots = append(tree.Roots, s)
+		}
+	}
+	return tree
+}
+
+// WaitWorkflowJob polls the workflow job with the given id every interval
+// until it finished or ctx is done. onTransition, if not nil, is called for
+// every node whose state changed since the previous poll, including the
+// initial state of every node.
+func WaitWorkflowJob(ctx context.Context, c Client, id int, interval time.Duration, onTransition func(WorkflowNodeTransition)) (*WorkflowJob, error) {
+	states := map[int]string{}
+	return waitFinished(ctx, interval, func() (*WorkflowJob, string, error) {
+		// The nodes are fetched after the workflow job, so that the last
+		// poll reports the final state of every node.
+		wj, err := GetWorkflowJob(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		nodes, err := ListWorkflowJobNodes(ctx, c, id)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, n := range nodes {
+			state := nodeState(n)
+			old, seen := states[n.ID]
+			if seen && old == state {
+				continue
+			}
+			states[n.ID] = state
+			if onTransition != nil {
+				onTransition(WorkflowNodeTransition{Node: n, OldState: old, NewState: state})
+			}
+		}
+		return wj, wj.Status, nil
+	})
+}
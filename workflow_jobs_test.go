@@ -0,0 +1,112 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testWorkflowJobNodes = `{
+	"count": 3,
+	"results": [
+		{"id": 1, "identifier": "build", "workflow_job": 5, "job": 100, "success_nodes": [2], "failure_nodes": [3],
+			"summary_fields": {"job": {"id": 100, "name": "build", "type": "job", "status": "successful"}}},
+		{"id": 2, "identifier": "deploy", "workflow_job": 5, "job": null, "do_not_run": false},
+		{"id": 3, "identifier": "notify", "workflow_job": 5, "job": null, "do_not_run": true}
+	]
+}`
+
+func newWorkflowJobServer(t *testing.T, status func() string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workflow_jobs/5/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"id": 5, "name": "deploy", "type": "workflow_job", "status": "` + status() + `"}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /workflow_jobs/5/workflow_nodes/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(testWorkflowJobNodes))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /jobs/100/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"id": 100, "name": "build", "status": "successful"}`))
+		assert.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestGetWorkflowJobTree(t *testing.T) {
+	server := newWorkflowJobServer(t, func() string { return "running" })
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	tree, err := GetWorkflowJobTree(context.Background(), client, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "running", tree.WorkflowJob.Status)
+	assert.Equal(t, 1, len(tree.Roots))
+	root := tree.Roots[0]
+	assert.Equal(t, JobStatusSuccessful, root.State)
+	assert.Equal(t, 100, root.Job.ID)
+	assert.Equal(t, WorkflowNodeStatePending, root.Success[0].State)
+	assert.Equal(t, WorkflowNodeStateSkipped, root.Failure[0].State)
+	assert.Nil(t, root.Success[0].Job)
+}
+
+func TestWaitWorkflowJob(t *testing.T) {
+	polls := 0
+	server := newWorkflowJobServer(t, func() string {
+		polls++
+		if polls < 2 {
+			return "running"
+		}
+		return "successful"
+	})
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	var transitions []WorkflowNodeTransition
+	wj, err := WaitWorkflowJob(context.Background(), client, 5, time.Millisecond, func(tr WorkflowNodeTransition) {
+		transitions = append(transitions, tr)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, JobStatusSuccessful, wj.Status)
+	assert.Equal(t, 2, polls)
+	// The nodes did not change between the polls.
+	assert.Equal(t, 3, len(transitions))
+	assert.Equal(t, "", transitions[0].OldState)
+	assert.Equal(t, JobStatusSuccessful, transitions[0].NewState)
+}
@@ -0,0 +1,354 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// WorkflowNodeEdge represents the kind of edge between two workflow nodes.
+type WorkflowNodeEdge string
+
+// Edges between workflow nodes, named after their AWX sub lists.
+const (
+	WorkflowNodeSuccess WorkflowNodeEdge = "success_nodes"
+	WorkflowNodeFailure WorkflowNodeEdge = "failure_nodes"
+	WorkflowNodeAlways  WorkflowNodeEdge = "always_nodes"
+)
+
+var workflowNodeEdges = []WorkflowNodeEdge{WorkflowNodeSuccess, WorkflowNodeFailure, WorkflowNodeAlways}
+
+// WorkflowJobTemplateNodeList represents the output of the ListWorkflowJobTemplateNodes method.
+type WorkflowJobTemplateNodeList struct {
+	ListGetResponse
+	Results []*WorkflowJobTemplateNode `json:"results,omitempty"`
+}
+
+// WorkflowJobTemplateNode represents a node of a workflow job template.
+type WorkflowJobTemplateNode struct {
+	ID                     int            `json:"id,omitempty"`
+	URL                    string         `json:"url,omitempty"`
+	Type                   string         `json:"type,omitempty"`
+	Modified               string         `json:"modified,omitempty"`
+	Created                string         `json:"created,omitempty"`
+	WorkflowJobTemplate    int            `json:"workflow_job_template"`
+	UnifiedJobTemplate     int            `json:"unified_job_template,omitempty"`
+	Identifier             string         `json:"identifier"`
+	AllParentsMustConverge bool           `json:"all_parents_must_converge"`
+	ExtraData              map[string]any `json:"extra_data,omitempty"`
+	Inventory              *int           `json:"inventory,omitempty"`
+	Limit                  *string        `json:"limit,omitempty"`
+	SCMBranch              *string        `json:"scm_branch,omitempty"`
+	JobType                *string        `json:"job_type,omitempty"`
+	JobTags                *string        `json:"job_tags,omitempty"`
+	SkipTags               *string        `json:"skip_tags,omitempty"`
+	Verbosity              *int           `json:"verbosity,omitempty"`
+	DiffMode               *bool          `json:"diff_mode,omitempty"`
+	SuccessNodes           []int          `json:"success_nodes,omitempty"`
+	FailureNodes           []int          `json:"failure_nodes,omitempty"`
+	AlwaysNodes            []int          `json:"always_nodes,omitempty"`
+}
+
+// edges returns the ids of the children connected by edge.
+func (n *WorkflowJobTemplateNode) edges(edge WorkflowNodeEdge) []int {
+	switch edge {
+	case WorkflowNodeSuccess:
+		return n.SuccessNodes
+	case WorkflowNodeFailure:
+		return n.FailureNodes
+	default:
+		return n.AlwaysNodes
+	}
+}
+
+// WorkflowGraph represents the nodes of a workflow job template as a DAG.
+// Nodes refer to each other by their identifier.
+type WorkflowGraph struct {
+	Nodes []*WorkflowGraphNode
+}
+
+// WorkflowGraphNode represents a node of a WorkflowGraph. The edge lists of
+// Node are ignored in favour of Success, Failure and Always.
+type WorkflowGraphNode struct {
+	Node    WorkflowJobTemplateNode
+	Success []string
+	Failure []string
+	Always  []string
+}
+
+// Children returns the identifiers of the children connected by edge.
+func (n *WorkflowGraphNode) Children(edge WorkflowNodeEdge) []string {
+	switch edge {
+	case WorkflowNodeSuccess:
+		return n.Success
+	case WorkflowNodeFailure:
+		return n.Failure
+	default:
+		return n.Always
+	}
+}
+
+// Node returns the node with the given identifier or nil.
+func (g *WorkflowGraph) Node(identifier string) *WorkflowGraphNode {
+	for _, n := range g.Nodes {
+		if n.Node.Identifier == identifier {
+			return n
+		}
+	}
+	return nil
+}
+
+// Roots returns the nodes without parents.
+func (g *WorkflowGraph) Roots() []*WorkflowGraphNode {
+	hasParent := map[string]bool{}
+	for _, n := range g.Nodes {
+		for _, edge := range workflowNodeEdges {
+			for _, child := range n.Children(edge) {
+				hasParent[child] = true
+			}
+		}
+	}
+	var roots []*WorkflowGraphNode
+	for _, n := range g.Nodes {
+		if !hasParent[n.Node.Identifier] {
+			roots = append(roots, n)
+		}
+	}
+	return roots
+}
+
+// Validate checks that every node has a unique identifier and a unified job
+// template, that all edges point to nodes of the graph and that the graph
+// does not contain cycles.
+func (g *WorkflowGraph) Validate() error {
+	var errs []error
+	nodes := map[string]*WorkflowGraphNode{}
+	for _, n := range g.Nodes {
+		id := n.Node.Identifier
+		switch {
+		case id == "":
+			errs = append(errs, errors.New("workflow node without identifier"))
+			continue
+		case nodes[id] != nil:
+			errs = append(errs, fmt.Errorf("workflow node %q: duplicate identifier", id))
+		}
+		nodes[id] = n
+		if n.Node.UnifiedJobTemplate == 0 {
+			errs = append(errs, fmt.Errorf("workflow node %q: unified job template is mandatory", id))
+		}
+	}
+	for _, n := range g.Nodes {
+		for _, edge := range workflowNodeEdges {
+			for _, child := range n.Children(edge) {
+				if nodes[child] == nil {
+					errs = append(errs, fmt.Errorf("workflow node %q: %s references unknown node %q", n.Node.Identifier, edge, child))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Depth first search, a node that is reached again while still on the
+	// stack closes a cycle.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("workflow node %q: cycle detected", id)
+		case visited:
+			return nil
+		}
+		state[id] = visiting
+		for _, edge := range workflowNodeEdges {
+			for _, child := range nodes[id].Children(edge) {
+				if err := visit(child); err != nil {
+					return err
+				}
+			}
+		}
+		state[id] = visited
+		return nil
+	}
+	for _, n := range g.Nodes {
+		if err := visit(n.Node.Identifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func workflowNodesKey(templateID int) ObjectKey {
+	return ObjectKey{Resource: workflowJobTemplatesResource, ResourceID: strconv.Itoa(templateID), Action: "workflow_nodes"}
+}
+
+func workflowNodeEdgeKey(nodeID int, edge WorkflowNodeEdge) ObjectKey {
+	return ObjectKey{Resource: "workflow_job_template_nodes", ResourceID: strconv.Itoa(nodeID), Action: string(edge)}
+}
+
+// ListWorkflowJobTemplateNodes retrieves all nodes of the given workflow job template.
+func ListWorkflowJobTemplateNodes(ctx context.Context, c Client, templateID int) ([]*WorkflowJobTemplateNode, error) {
+	return listAll[WorkflowJobTemplateNode](ctx, c, workflowNodesKey(templateID), nil)
+}
+
+// GetWorkflowGraph retrieves the nodes of the given workflow job template as
+// a graph. Nodes without identifier are given their id as identifier.
+func GetWorkflowGraph(ctx context.Context, c Client, templateID int) (*WorkflowGraph, error) {
+	nodes, err := ListWorkflowJobTemplateNodes(ctx, c, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return newWorkflowGraph(nodes), nil
+}
+
+func newWorkflowGraph(nodes []*WorkflowJobTemplateNode) *WorkflowGraph {
+	identifiers := map[int]string{}
+	for _, n := range nodes {
+		if n.Identifier == "" {
+			n.Identifier = strconv.Itoa(n.ID)
+		}
+		identifiers[n.ID] = n.Identifier
+	}
+	g := &WorkflowGraph{}
+	for _, n := range nodes {
+		gn := &WorkflowGraphNode{Node: *n}
+		for _, id := range n.SuccessNodes {
+			gn.Success = append(gn.Success, identifiers[id])
+		}
+		for _, id := range n.FailureNodes {
+			gn.Failure = append(gn.Failure, identifiers[id])
+		}
+		for _, id := range n.AlwaysNodes {
+			gn.Always = append(gn.Always, identifiers[id])
+		}
+		g.Nodes = append(g.Nodes, gn)
+	}
+	return g
+}
+
+// SyncWorkflowGraph makes the nodes of the given workflow job template match
+// graph. Existing nodes are matched by identifier and only updated when
+// they differ; missing nodes are created, superfluous nodes are deleted and
+// edges are associated or disassociated as needed. The ids of the nodes in
+// graph are updated with the ids in AWX.
+func SyncWorkflowGraph(ctx context.Context, c Client, templateID int, graph *WorkflowGraph) error {
+	if err := graph.Validate(); err != nil {
+		return err
+	}
+	existing, err := ListWorkflowJobTemplateNodes(ctx, c, templateID)
+	if err != nil {
+		return err
+	}
+	current := map[string]*WorkflowJobTemplateNode{}
+	for _, n := range existing {
+		current[n.Identifier] = n
+	}
+
+	ids := map[string]int{}
+	kept := map[int]bool{}
+	for _, gn := range graph.Nodes {
+		desired := gn.Node
+		desired.WorkflowJobTemplate = templateID
+		desired.SuccessNodes, desired.FailureNodes, desired.AlwaysNodes = nil, nil, nil
+		cur, ok := current[desired.Identifier]
+		switch {
+		case !ok:
+			desired.ID = 0
+			err = c.Create(ctx, workflowNodesKey(templateID), &desired, nil)
+		case !workflowNodeSettingsEqual(cur, &desired):
+			desired.ID = cur.ID
+			err = c.Update(ctx, idKey("workflow_job_template_nodes", cur.ID), &desired, nil)
+		default:
+			desired = *cur
+		}
+		if err != nil {
+			return fmt.Errorf("workflow node %q: %w", gn.Node.Identifier, err)
+		}
+		gn.Node.ID = desired.ID
+		ids[desired.Identifier] = desired.ID
+		kept[desired.ID] = true
+		delete(current, desired.Identifier)
+	}
+	// Deleting a node also removes its edges, so only edges between kept
+	// nodes are disassociated below.
+	for _, n := range current {
+		if err = c.Delete(ctx, idKey("workflow_job_template_nodes", n.ID), nil); err != nil {
+			return fmt.Errorf("workflow node %q: %w", n.Identifier, err)
+		}
+	}
+
+	currentEdges := map[int]*WorkflowJobTemplateNode{}
+	for _, n := range existing {
+		currentEdges[n.ID] = n
+	}
+	for _, gn := range graph.Nodes {
+		for _, edge := range workflowNodeEdges {
+			var want []int
+			for _, child := range gn.Children(edge) {
+				want = append(want, ids[child])
+			}
+			var have []int
+			if cur := currentEdges[gn.Node.ID]; cur != nil {
+				have = cur.edges(edge)
+			}
+			key := workflowNodeEdgeKey(gn.Node.ID, edge)
+			for _, id := range have {
+				if !slices.Contains(want, id) && kept[id] {
+					if err = Disassociate(ctx, c, key, id); err != nil {
+						return fmt.Errorf("workflow node %q: %w", gn.Node.Identifier, err)
+					}
+				}
+			}
+			for _, id := range want {
+				if !slices.Contains(have, id) {
+					if err = Associate(ctx, c, key, id); err != nil {
+						return fmt.Errorf("workflow node %q: %w", gn.Node.Identifier, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// workflowNodeSettingsEqual reports whether a and b only differ in read only
+// fields and edges.
+func workflowNodeSettingsEqual(a, b *WorkflowJobTemplateNode) bool {
+	strip := func(n WorkflowJobTemplateNode) WorkflowJobTemplateNode {
+		n.ID, n.URL, n.Type, n.Modified, n.Created = 0, "", "", "", ""
+		n.SuccessNodes, n.FailureNodes, n.AlwaysNodes = nil, nil, nil
+		if len(n.ExtraData) == 0 {
+			n.ExtraData = nil
+		}
+		return n
+	}
+	return reflect.DeepEqual(strip(*a), strip(*b))
+}
@@ -0,0 +1,106 @@
+/******************************************************************************
+*
+*  Copyright 2025 SAP SE
+*
+*  Licensed under the Apache License, Version 2.0 (the "License");
+*  you may not use this file except in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*      http://www.apache.org/licenses/LICENSE-2.0
+*
+*  Unless required by applicable law or agreed to in writing, software
+*  distributed under the License is distributed on an "AS IS" BASIS,
+*  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+*  See the License for the specific language governing permissions and
+*  limitations under the License.
+*
+******************************************************************************/
+
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowGraphValidate(t *testing.T) {
+	graph := WorkflowGraph{Nodes: []*WorkflowGraphNode{
+		{Node: WorkflowJobTemplateNode{Identifier: "a", UnifiedJobTemplate: 1}, Success: []string{"b"}},
+		{Node: WorkflowJobTemplateNode{Identifier: "b", UnifiedJobTemplate: 1}, Always: []string{"c"}},
+		{Node: WorkflowJobTemplateNode{Identifier: "c", UnifiedJobTemplate: 1}},
+	}}
+	assert.NoError(t, graph.Validate())
+	assert.Equal(t, 1, len(graph.Roots()))
+
+	graph.Nodes[2].Failure = []string{"a"}
+	assert.ErrorContains(t, graph.Validate(), "cycle detected")
+
+	graph.Nodes[2].Failure = []string{"d"}
+	assert.ErrorContains(t, graph.Validate(), `workflow node "c": failure_nodes references unknown node "d"`)
+}
+
+func TestSyncWorkflowGraph(t *testing.T) {
+	var calls []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workflow_job_templates/7/workflow_nodes/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{
+			"count": 3,
+			"results": [
+				{"id": 1, "identifier": "build", "unified_job_template": 10, "workflow_job_template": 7, "success_nodes": [2]},
+				{"id": 2, "identifier": "deploy", "unified_job_template": 11, "workflow_job_template": 7},
+				{"id": 3, "identifier": "old", "unified_job_template": 12, "workflow_job_template": 7}
+			]
+		}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("POST /workflow_job_templates/7/workflow_nodes/", func(w http.ResponseWriter, r *http.Request) {
+		var received WorkflowJobTemplateNode
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		calls = append(calls, "create "+received.Identifier)
+		received.ID = 4
+		w.WriteHeader(http.StatusCreated)
+		err = json.NewEncoder(w).Encode(received)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("DELETE /workflow_job_template_nodes/{id}/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "delete "+r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /workflow_job_template_nodes/{id}/{edge}/", func(w http.ResponseWriter, r *http.Request) {
+		var received AssociateInput
+		err := json.NewDecoder(r.Body).Decode(&received)
+		assert.NoError(t, err)
+		calls = append(calls, r.PathValue("edge")+" "+r.PathValue("id")+"->"+strconv.Itoa(received.ID))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := NewClient(ClientOptions{
+		Endpoint: server.URL + "/",
+		Token:    "12345",
+	})
+	assert.NoError(t, err)
+
+	graph := &WorkflowGraph{Nodes: []*WorkflowGraphNode{
+		{Node: WorkflowJobTemplateNode{Identifier: "build", UnifiedJobTemplate: 10}, Success: []string{"deploy"}, Failure: []string{"notify"}},
+		{Node: WorkflowJobTemplateNode{Identifier: "deploy", UnifiedJobTemplate: 11}},
+		{Node: WorkflowJobTemplateNode{Identifier: "notify", UnifiedJobTemplate: 13}},
+	}}
+	err = SyncWorkflowGraph(context.Background(), client, 7, graph)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"create notify",
+		"delete 3",
+		"failure_nodes 1->4",
+	}, calls)
+	assert.Equal(t, 4, graph.Node("notify").Node.ID)
+}